@@ -1,11 +1,18 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2025 The go-steamworks Authors
 
+//go:build !nosteam && ((linux && !android) || (darwin && !ios) || (freebsd && cgo) || windows) && (amd64 || arm64)
+
 package steamworks
 
 import (
-	"bytes"
+	"context"
 	"fmt"
+	"image"
+	"iter"
+	"strings"
+	"sync"
+	"time"
 	"unsafe"
 
 	"github.com/ebitengine/purego"
@@ -17,22 +24,77 @@ type lib struct {
 
 var (
 	// General
-	ptrAPI_RestartAppIfNecessary func(uint32) bool
-	ptrAPI_InitFlat              func(uintptr) ESteamAPIInitResult
-	ptrAPI_RunCallbacks          func()
+	ptrAPI_RestartAppIfNecessary       func(uint32) bool
+	ptrAPI_InitFlat                    func(uintptr) ESteamAPIInitResult
+	ptrAPI_SteamInternal_SteamAPI_Init func(uintptr, uintptr) ESteamAPIInitResult
+	ptrAPI_Shutdown                    func()
+	ptrAPI_IsSteamRunning              func() bool
+	ptrAPI_ReleaseCurrentThreadMemory  func()
+	ptrAPI_GetHSteamPipe               func() HSteamPipe
+
+	ptrAPI_ManualDispatch_Init             func()
+	ptrAPI_ManualDispatch_RunFrame         func(HSteamPipe)
+	ptrAPI_ManualDispatch_GetNextCallback  func(HSteamPipe, uintptr) bool
+	ptrAPI_ManualDispatch_FreeLastCallback func(HSteamPipe)
+	ptrAPI_ManualDispatch_GetAPICallResult func(HSteamPipe, SteamAPICall_t, uintptr, int32, int32, uintptr) bool
 
 	// ISteamApps
-	ptrAPI_SteamApps                         func() uintptr
-	ptrAPI_ISteamApps_BGetDLCDataByIndex     func(uintptr, int32, uintptr, uintptr, uintptr, int32) bool
-	ptrAPI_ISteamApps_BIsDlcInstalled        func(uintptr, AppId_t) bool
-	ptrAPI_ISteamApps_GetAppInstallDir       func(uintptr, AppId_t, uintptr, int32) int32
-	ptrAPI_ISteamApps_GetCurrentGameLanguage func(uintptr) string
-	ptrAPI_ISteamApps_GetDLCCount            func(uintptr) int32
+	ptrAPI_SteamApps                                 func() uintptr
+	ptrAPI_ISteamApps_BGetDLCDataByIndex             func(uintptr, int32, uintptr, uintptr, uintptr, int32) bool
+	ptrAPI_ISteamApps_BIsDlcInstalled                func(uintptr, AppId_t) bool
+	ptrAPI_ISteamApps_GetAppInstallDir               func(uintptr, AppId_t, uintptr, int32) int32
+	ptrAPI_ISteamApps_GetCurrentGameLanguage         func(uintptr) string
+	ptrAPI_ISteamApps_GetDLCCount                    func(uintptr) int32
+	ptrAPI_ISteamApps_BIsSubscribed                  func(uintptr) bool
+	ptrAPI_ISteamApps_BIsSubscribedApp               func(uintptr, AppId_t) bool
+	ptrAPI_ISteamApps_BIsLowViolence                 func(uintptr) bool
+	ptrAPI_ISteamApps_BIsCybercafe                   func(uintptr) bool
+	ptrAPI_ISteamApps_BIsVACBanned                   func(uintptr) bool
+	ptrAPI_ISteamApps_BIsSubscribedFromFreeWeekend   func(uintptr) bool
+	ptrAPI_ISteamApps_BIsSubscribedFromFamilySharing func(uintptr) bool
+	ptrAPI_ISteamApps_GetAppOwner                    func(uintptr) CSteamID
+	ptrAPI_ISteamApps_GetAppBuildId                  func(uintptr) int32
+	ptrAPI_ISteamApps_GetCurrentBetaName             func(uintptr, uintptr, int32) bool
+	ptrAPI_ISteamApps_GetAvailableGameLanguages      func(uintptr) string
+	ptrAPI_ISteamApps_GetEarliestPurchaseUnixTime    func(uintptr, AppId_t) uint32
+	ptrAPI_ISteamApps_GetLaunchQueryParam            func(uintptr, string) string
+	ptrAPI_ISteamApps_GetLaunchCommandLine           func(uintptr, uintptr, int32) int32
+	ptrAPI_ISteamApps_BIsTimedTrial                  func(uintptr, uintptr, uintptr) bool
+	ptrAPI_ISteamApps_InstallDLC                     func(uintptr, AppId_t)
+	ptrAPI_ISteamApps_UninstallDLC                   func(uintptr, AppId_t)
+	ptrAPI_ISteamApps_GetDlcDownloadProgress         func(uintptr, AppId_t, uintptr, uintptr) bool
+	ptrAPI_ISteamApps_BIsAppInstalled                func(uintptr, AppId_t) bool
+	ptrAPI_ISteamApps_GetNumBetas                    func(uintptr, uintptr, uintptr) int32
+	ptrAPI_ISteamApps_GetBetaInfo                    func(uintptr, int32, uintptr, uintptr, uintptr, int32, uintptr, int32) bool
+	ptrAPI_ISteamApps_SetActiveBeta                  func(uintptr, string) bool
 
 	// ISteamFriends
-	ptrAPI_SteamFriends                  func() uintptr
-	ptrAPI_ISteamFriends_GetPersonaName  func(uintptr) string
-	ptrAPI_ISteamFriends_SetRichPresence func(uintptr, string, string) bool
+	ptrAPI_SteamFriends                                               func() uintptr
+	ptrAPI_ISteamFriends_GetPersonaName                               func(uintptr) string
+	ptrAPI_ISteamFriends_SetRichPresence                              func(uintptr, string, string) bool
+	ptrAPI_ISteamFriends_GetFriendCount                               func(uintptr, EFriendFlags) int32
+	ptrAPI_ISteamFriends_GetFriendByIndex                             func(uintptr, int32, EFriendFlags) CSteamID
+	ptrAPI_ISteamFriends_GetFriendPersonaName                         func(uintptr, CSteamID) string
+	ptrAPI_ISteamFriends_GetFriendPersonaState                        func(uintptr, CSteamID) EPersonaState
+	ptrAPI_ISteamFriends_GetFriendRelationship                        func(uintptr, CSteamID) EFriendRelationship
+	ptrAPI_ISteamFriends_GetFriendGamePlayed                          func(uintptr, CSteamID, uintptr) bool
+	ptrAPI_ISteamFriends_GetPlayerNickname                            func(uintptr, CSteamID) string
+	ptrAPI_ISteamFriends_GetFriendSteamLevel                          func(uintptr, CSteamID) int32
+	ptrAPI_ISteamFriends_GetSmallFriendAvatar                         func(uintptr, CSteamID) int32
+	ptrAPI_ISteamFriends_GetMediumFriendAvatar                        func(uintptr, CSteamID) int32
+	ptrAPI_ISteamFriends_GetLargeFriendAvatar                         func(uintptr, CSteamID) int32
+	ptrAPI_ISteamFriends_RequestUserInformation                       func(uintptr, CSteamID, bool) bool
+	ptrAPI_ISteamFriends_ActivateGameOverlay                          func(uintptr, string)
+	ptrAPI_ISteamFriends_ActivateGameOverlayToUser                    func(uintptr, string, CSteamID)
+	ptrAPI_ISteamFriends_ActivateGameOverlayToWebPage                 func(uintptr, string, EActivateGameOverlayToWebPageMode)
+	ptrAPI_ISteamFriends_ActivateGameOverlayToStore                   func(uintptr, AppId_t, EOverlayToStoreFlag)
+	ptrAPI_ISteamFriends_ActivateGameOverlayInviteDialog              func(uintptr, CSteamID)
+	ptrAPI_ISteamFriends_ActivateGameOverlayInviteDialogConnectString func(uintptr, string)
+	ptrAPI_ISteamFriends_ClearRichPresence                            func(uintptr)
+	ptrAPI_ISteamFriends_GetFriendRichPresence                        func(uintptr, CSteamID, string) string
+	ptrAPI_ISteamFriends_GetFriendRichPresenceKeyCount                func(uintptr, CSteamID) int32
+	ptrAPI_ISteamFriends_GetFriendRichPresenceKeyByIndex              func(uintptr, CSteamID, int32) string
+	ptrAPI_ISteamFriends_RequestFriendRichPresence                    func(uintptr, CSteamID)
 
 	// ISteamInput
 	ptrAPI_SteamInput                          func() uintptr
@@ -42,11 +104,12 @@ var (
 	ptrAPI_ISteamInput_RunFrame                func(uintptr, bool)
 
 	// ISteamRemoteStorage
-	ptrAPI_SteamRemoteStorage              func() uintptr
-	ptrAPI_ISteamRemoteStorage_FileWrite   func(uintptr, string, uintptr, int32) bool
-	ptrAPI_ISteamRemoteStorage_FileRead    func(uintptr, string, uintptr, int32) int32
-	ptrAPI_ISteamRemoteStorage_FileDelete  func(uintptr, string) bool
-	ptrAPI_ISteamRemoteStorage_GetFileSize func(uintptr, string) int32
+	ptrAPI_SteamRemoteStorage                 func() uintptr
+	ptrAPI_ISteamRemoteStorage_FileWrite      func(uintptr, string, uintptr, int32) bool
+	ptrAPI_ISteamRemoteStorage_FileWriteAsync func(uintptr, string, uintptr, uint32) SteamAPICall_t
+	ptrAPI_ISteamRemoteStorage_FileRead       func(uintptr, string, uintptr, int32) int32
+	ptrAPI_ISteamRemoteStorage_FileDelete     func(uintptr, string) bool
+	ptrAPI_ISteamRemoteStorage_GetFileSize    func(uintptr, string) int32
 
 	// ISteamUser
 	ptrAPI_SteamUser             func() uintptr
@@ -60,17 +123,48 @@ var (
 	ptrAPI_ISteamUserStats_StoreStats       func(uintptr) bool
 
 	// ISteamUtils
-	ptrAPI_SteamUtils                               func() uintptr
-	ptrAPI_ISteamUtils_IsOverlayEnabled             func(uintptr) bool
-	ptrAPI_ISteamUtils_IsSteamRunningOnSteamDeck    func(uintptr) bool
-	ptrAPI_ISteamUtils_ShowFloatingGamepadTextInput func(uintptr, EFloatingGamepadTextInputMode, int32, int32, int32, int32) bool
+	ptrAPI_SteamUtils                                 func() uintptr
+	ptrAPI_ISteamUtils_IsOverlayEnabled               func(uintptr) bool
+	ptrAPI_ISteamUtils_IsSteamRunningOnSteamDeck      func(uintptr) bool
+	ptrAPI_ISteamUtils_ShowFloatingGamepadTextInput   func(uintptr, EFloatingGamepadTextInputMode, int32, int32, int32, int32) bool
+	ptrAPI_ISteamUtils_GetImageSize                   func(uintptr, int32, uintptr, uintptr) bool
+	ptrAPI_ISteamUtils_GetImageRGBA                   func(uintptr, int32, uintptr, int32) bool
+	ptrAPI_ISteamUtils_BOverlayNeedsPresent           func(uintptr) bool
+	ptrAPI_ISteamUtils_SetOverlayNotificationPosition func(uintptr, ENotificationPosition)
+	ptrAPI_ISteamUtils_SetOverlayNotificationInset    func(uintptr, int32, int32)
 )
 
+// registerGeneratedFunctions registers the functions in the generated file zapi.go.
+// registerGeneratedFunctions is nil if zapi.go does not exist.
+//
+// Unlike the hand-written functions, a generated function missing in the library is left nil,
+// so that an older library without it can still be loaded.
+var registerGeneratedFunctions func(lib uintptr)
+
+// registerLibFuncIfExists is like purego.RegisterLibFunc, but leaves fptr as it is if the library doesn't have the function.
+func registerLibFuncIfExists(fptr any, lib uintptr, name string) {
+	sym, err := lookupSymbol(lib, name)
+	if err != nil || sym == 0 {
+		return
+	}
+	purego.RegisterFunc(fptr, sym)
+}
+
 func registerFunctions(lib uintptr) {
 	// General
 	purego.RegisterLibFunc(&ptrAPI_RestartAppIfNecessary, lib, flatAPI_RestartAppIfNecessary)
 	purego.RegisterLibFunc(&ptrAPI_InitFlat, lib, flatAPI_InitFlat)
-	purego.RegisterLibFunc(&ptrAPI_RunCallbacks, lib, flatAPI_RunCallbacks)
+	purego.RegisterLibFunc(&ptrAPI_SteamInternal_SteamAPI_Init, lib, flatAPI_SteamInternal_SteamAPI_Init)
+	purego.RegisterLibFunc(&ptrAPI_Shutdown, lib, flatAPI_Shutdown)
+	purego.RegisterLibFunc(&ptrAPI_IsSteamRunning, lib, flatAPI_IsSteamRunning)
+	purego.RegisterLibFunc(&ptrAPI_ReleaseCurrentThreadMemory, lib, flatAPI_ReleaseCurrentThreadMemory)
+	purego.RegisterLibFunc(&ptrAPI_GetHSteamPipe, lib, flatAPI_GetHSteamPipe)
+
+	purego.RegisterLibFunc(&ptrAPI_ManualDispatch_Init, lib, flatAPI_ManualDispatch_Init)
+	purego.RegisterLibFunc(&ptrAPI_ManualDispatch_RunFrame, lib, flatAPI_ManualDispatch_RunFrame)
+	purego.RegisterLibFunc(&ptrAPI_ManualDispatch_GetNextCallback, lib, flatAPI_ManualDispatch_GetNextCallback)
+	purego.RegisterLibFunc(&ptrAPI_ManualDispatch_FreeLastCallback, lib, flatAPI_ManualDispatch_FreeLastCallback)
+	purego.RegisterLibFunc(&ptrAPI_ManualDispatch_GetAPICallResult, lib, flatAPI_ManualDispatch_GetAPICallResult)
 
 	// ISteamApps
 	purego.RegisterLibFunc(&ptrAPI_SteamApps, lib, flatAPI_SteamApps)
@@ -79,11 +173,56 @@ func registerFunctions(lib uintptr) {
 	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetAppInstallDir, lib, flatAPI_ISteamApps_GetAppInstallDir)
 	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetCurrentGameLanguage, lib, flatAPI_ISteamApps_GetCurrentGameLanguage)
 	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetDLCCount, lib, flatAPI_ISteamApps_GetDLCCount)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_BIsSubscribed, lib, flatAPI_ISteamApps_BIsSubscribed)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_BIsSubscribedApp, lib, flatAPI_ISteamApps_BIsSubscribedApp)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_BIsLowViolence, lib, flatAPI_ISteamApps_BIsLowViolence)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_BIsCybercafe, lib, flatAPI_ISteamApps_BIsCybercafe)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_BIsVACBanned, lib, flatAPI_ISteamApps_BIsVACBanned)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_BIsSubscribedFromFreeWeekend, lib, flatAPI_ISteamApps_BIsSubscribedFromFreeWeekend)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_BIsSubscribedFromFamilySharing, lib, flatAPI_ISteamApps_BIsSubscribedFromFamilySharing)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetAppOwner, lib, flatAPI_ISteamApps_GetAppOwner)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetAppBuildId, lib, flatAPI_ISteamApps_GetAppBuildId)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetCurrentBetaName, lib, flatAPI_ISteamApps_GetCurrentBetaName)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetAvailableGameLanguages, lib, flatAPI_ISteamApps_GetAvailableGameLanguages)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetEarliestPurchaseUnixTime, lib, flatAPI_ISteamApps_GetEarliestPurchaseUnixTime)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetLaunchQueryParam, lib, flatAPI_ISteamApps_GetLaunchQueryParam)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetLaunchCommandLine, lib, flatAPI_ISteamApps_GetLaunchCommandLine)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_BIsTimedTrial, lib, flatAPI_ISteamApps_BIsTimedTrial)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_InstallDLC, lib, flatAPI_ISteamApps_InstallDLC)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_UninstallDLC, lib, flatAPI_ISteamApps_UninstallDLC)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetDlcDownloadProgress, lib, flatAPI_ISteamApps_GetDlcDownloadProgress)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_BIsAppInstalled, lib, flatAPI_ISteamApps_BIsAppInstalled)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetNumBetas, lib, flatAPI_ISteamApps_GetNumBetas)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_GetBetaInfo, lib, flatAPI_ISteamApps_GetBetaInfo)
+	purego.RegisterLibFunc(&ptrAPI_ISteamApps_SetActiveBeta, lib, flatAPI_ISteamApps_SetActiveBeta)
 
 	// ISteamFriends
 	purego.RegisterLibFunc(&ptrAPI_SteamFriends, lib, flatAPI_SteamFriends)
 	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetPersonaName, lib, flatAPI_ISteamFriends_GetPersonaName)
 	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_SetRichPresence, lib, flatAPI_ISteamFriends_SetRichPresence)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetFriendCount, lib, flatAPI_ISteamFriends_GetFriendCount)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetFriendByIndex, lib, flatAPI_ISteamFriends_GetFriendByIndex)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetFriendPersonaName, lib, flatAPI_ISteamFriends_GetFriendPersonaName)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetFriendPersonaState, lib, flatAPI_ISteamFriends_GetFriendPersonaState)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetFriendRelationship, lib, flatAPI_ISteamFriends_GetFriendRelationship)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetFriendGamePlayed, lib, flatAPI_ISteamFriends_GetFriendGamePlayed)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetPlayerNickname, lib, flatAPI_ISteamFriends_GetPlayerNickname)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetFriendSteamLevel, lib, flatAPI_ISteamFriends_GetFriendSteamLevel)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetSmallFriendAvatar, lib, flatAPI_ISteamFriends_GetSmallFriendAvatar)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetMediumFriendAvatar, lib, flatAPI_ISteamFriends_GetMediumFriendAvatar)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetLargeFriendAvatar, lib, flatAPI_ISteamFriends_GetLargeFriendAvatar)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_RequestUserInformation, lib, flatAPI_ISteamFriends_RequestUserInformation)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_ActivateGameOverlay, lib, flatAPI_ISteamFriends_ActivateGameOverlay)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_ActivateGameOverlayToUser, lib, flatAPI_ISteamFriends_ActivateGameOverlayToUser)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_ActivateGameOverlayToWebPage, lib, flatAPI_ISteamFriends_ActivateGameOverlayToWebPage)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_ActivateGameOverlayToStore, lib, flatAPI_ISteamFriends_ActivateGameOverlayToStore)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_ActivateGameOverlayInviteDialog, lib, flatAPI_ISteamFriends_ActivateGameOverlayInviteDialog)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_ActivateGameOverlayInviteDialogConnectString, lib, flatAPI_ISteamFriends_ActivateGameOverlayInviteDialogConnectString)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_ClearRichPresence, lib, flatAPI_ISteamFriends_ClearRichPresence)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetFriendRichPresence, lib, flatAPI_ISteamFriends_GetFriendRichPresence)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetFriendRichPresenceKeyCount, lib, flatAPI_ISteamFriends_GetFriendRichPresenceKeyCount)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_GetFriendRichPresenceKeyByIndex, lib, flatAPI_ISteamFriends_GetFriendRichPresenceKeyByIndex)
+	purego.RegisterLibFunc(&ptrAPI_ISteamFriends_RequestFriendRichPresence, lib, flatAPI_ISteamFriends_RequestFriendRichPresence)
 
 	// ISteamInput
 	purego.RegisterLibFunc(&ptrAPI_SteamInput, lib, flatAPI_SteamInput)
@@ -95,6 +234,7 @@ func registerFunctions(lib uintptr) {
 	// ISteamRemoteStorage
 	purego.RegisterLibFunc(&ptrAPI_SteamRemoteStorage, lib, flatAPI_SteamRemoteStorage)
 	purego.RegisterLibFunc(&ptrAPI_ISteamRemoteStorage_FileWrite, lib, flatAPI_ISteamRemoteStorage_FileWrite)
+	purego.RegisterLibFunc(&ptrAPI_ISteamRemoteStorage_FileWriteAsync, lib, flatAPI_ISteamRemoteStorage_FileWriteAsync)
 	purego.RegisterLibFunc(&ptrAPI_ISteamRemoteStorage_FileRead, lib, flatAPI_ISteamRemoteStorage_FileRead)
 	purego.RegisterLibFunc(&ptrAPI_ISteamRemoteStorage_FileDelete, lib, flatAPI_ISteamRemoteStorage_FileDelete)
 	purego.RegisterLibFunc(&ptrAPI_ISteamRemoteStorage_GetFileSize, lib, flatAPI_ISteamRemoteStorage_GetFileSize)
@@ -115,186 +255,1033 @@ func registerFunctions(lib uintptr) {
 	purego.RegisterLibFunc(&ptrAPI_ISteamUtils_IsOverlayEnabled, lib, flatAPI_ISteamUtils_IsOverlayEnabled)
 	purego.RegisterLibFunc(&ptrAPI_ISteamUtils_IsSteamRunningOnSteamDeck, lib, flatAPI_ISteamUtils_IsSteamRunningOnSteamDeck)
 	purego.RegisterLibFunc(&ptrAPI_ISteamUtils_ShowFloatingGamepadTextInput, lib, flatAPI_ISteamUtils_ShowFloatingGamepadTextInput)
+	purego.RegisterLibFunc(&ptrAPI_ISteamUtils_GetImageSize, lib, flatAPI_ISteamUtils_GetImageSize)
+	purego.RegisterLibFunc(&ptrAPI_ISteamUtils_GetImageRGBA, lib, flatAPI_ISteamUtils_GetImageRGBA)
+	purego.RegisterLibFunc(&ptrAPI_ISteamUtils_BOverlayNeedsPresent, lib, flatAPI_ISteamUtils_BOverlayNeedsPresent)
+	purego.RegisterLibFunc(&ptrAPI_ISteamUtils_SetOverlayNotificationPosition, lib, flatAPI_ISteamUtils_SetOverlayNotificationPosition)
+	purego.RegisterLibFunc(&ptrAPI_ISteamUtils_SetOverlayNotificationInset, lib, flatAPI_ISteamUtils_SetOverlayNotificationInset)
+
+	if registerGeneratedFunctions != nil {
+		registerGeneratedFunctions(lib)
+	}
+}
+
+var (
+	theLib  *lib
+	theLibM sync.Mutex
+)
+
+// Load loads the Steam API library.
+//
+// By default, Load uses the library embedded in this package on Linux and macOS,
+// and steam_api64.dll found by the system's search order on Windows.
+// Options can specify other sources of the library. See LoadOption.
+//
+// Load is called implicitly by Init and RestartAppIfNecessary.
+// Call Load explicitly to know whether the library is available, e.g., in order to run a game without Steam.
+// Load does nothing if the library is already loaded.
+func Load(opts ...LoadOption) error {
+	theLibM.Lock()
+	defer theLibM.Unlock()
+	return load(opts...)
 }
 
-var theLib *lib
+func load(opts ...LoadOption) error {
+	if theLib != nil {
+		return nil
+	}
+
+	var o loadOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
 
-func init() {
-	l, err := loadLib()
+	l, err := loadLib(&o)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	if err := registerFunctionsSafely(l); err != nil {
+		closeLib(l)
+		return err
 	}
-	registerFunctions(l)
 	theLib = &lib{
 		lib: l,
 	}
+	if State(theState.Load()) == StateNotLoaded {
+		theState.Store(int32(StateLoaded))
+	}
+	return nil
+}
+
+// registerFunctionsSafely calls registerFunctions and returns an error instead of panicking when a symbol is missing.
+func registerFunctionsSafely(lib uintptr) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("steamworks: registering functions failed: %v", r)
+		}
+	}()
+	registerFunctions(lib)
+	return nil
 }
 
+// RestartAppIfNecessary reports whether the game should restart through Steam.
+// RestartAppIfNecessary returns false if the library cannot be loaded.
 func RestartAppIfNecessary(appID uint32) bool {
-	return ptrAPI_RestartAppIfNecessary(appID)
+	if err := Load(); err != nil {
+		return false
+	}
+	return serialize(func() bool {
+		return ptrAPI_RestartAppIfNecessary(appID)
+	})
 }
 
+// Init initializes the Steam API.
+// If the initialization fails, Init returns an *InitError.
+//
+// Init loads the library by Load with the default options if the library is not loaded yet.
 func Init() error {
-	var msg steamErrMsg
-	if ptrAPI_InitFlat(uintptr(unsafe.Pointer(&msg))) != ESteamAPIInitResult_OK {
-		return fmt.Errorf("steamworks: InitFlat failed: %s", msg.String())
+	return initialize(func(msg *steamErrMsg) ESteamAPIInitResult {
+		return ptrAPI_InitFlat(uintptr(unsafe.Pointer(msg)))
+	})
+}
+
+// InitEx initializes the Steam API like Init,
+// and also checks that the Steam client supports the exact interface versions this package calls.
+// If an interface version is not supported, InitEx returns an *InitError with ESteamAPIInitResult_VersionMismatch,
+// whose message names the interface.
+func InitEx() error {
+	// The versions are passed as NUL-separated strings terminated by an empty string.
+	var versions []byte
+	for _, v := range interfaceVersions {
+		versions = append(versions, v...)
+		versions = append(versions, 0)
 	}
+	versions = append(versions, 0)
+
+	return initialize(func(msg *steamErrMsg) ESteamAPIInitResult {
+		return ptrAPI_SteamInternal_SteamAPI_Init(uintptr(unsafe.Pointer(&versions[0])), uintptr(unsafe.Pointer(msg)))
+	})
+}
+
+func initialize(f func(msg *steamErrMsg) ESteamAPIInitResult) error {
+	theLibM.Lock()
+	defer theLibM.Unlock()
+
+	if err := load(); err != nil {
+		return err
+	}
+	var r ESteamAPIInitResult
+	var errMsg string
+	serializeDo(func() {
+		var msg steamErrMsg
+		r = f(&msg)
+		if r != ESteamAPIInitResult_OK {
+			errMsg = msg.String()
+			return
+		}
+		ptrAPI_ManualDispatch_Init()
+	})
+	if r != ESteamAPIInitResult_OK {
+		return &InitError{
+			Result:  r,
+			Message: errMsg,
+		}
+	}
+	theState.Store(int32(StateInitialized))
 	return nil
 }
 
+// Shutdown shuts down the Steam API.
+//
+// After Shutdown, the accessors like SteamApps return the no-op implementations until Init is called again.
+func Shutdown() {
+	theLibM.Lock()
+	defer theLibM.Unlock()
+
+	if theLib == nil {
+		return
+	}
+	// Wait for the running dispatch, and stop a new dispatch until the state is updated.
+	runCallbacksM.Lock()
+	defer runCallbacksM.Unlock()
+
+	if State(theState.Load()) == StateInitialized {
+		serializeDo(func() {
+			ptrAPI_Shutdown()
+		})
+		abandonPendingCalls()
+	}
+	theState.Store(int32(StateShutDown))
+}
+
+// IsSteamRunning reports whether the Steam client is running.
+// IsSteamRunning returns false if the library is not loaded.
+func IsSteamRunning() bool {
+	if CurrentState() == StateNotLoaded {
+		return false
+	}
+	return serialize(func() bool {
+		return ptrAPI_IsSteamRunning()
+	})
+}
+
+// ReleaseCurrentThreadMemory frees the internal Steam memory associated with the calling thread.
+// ReleaseCurrentThreadMemory does nothing if the library is not loaded.
+func ReleaseCurrentThreadMemory() {
+	if CurrentState() == StateNotLoaded {
+		return
+	}
+	serializeDo(func() {
+		ptrAPI_ReleaseCurrentThreadMemory()
+	})
+}
+
+// RunCallbacks dispatches the pending callbacks to the handlers registered by OnCallback,
+// and delivers the results of asynchronous calls to CallResult.
+//
+// RunCallbacks does nothing if the Steam API is not initialized.
+//
+// Steam expects callbacks to be dispatched regularly on a consistent thread.
+// Use StartCallbackLoop or Frame for that.
 func RunCallbacks() {
-	ptrAPI_RunCallbacks()
+	// Handlers are invoked after the dispatching finishes, so that a handler can call Steam APIs.
+	for _, f := range dispatchCallbacks() {
+		f()
+	}
 }
 
+// runCallbacksM prevents the manual dispatch from running concurrently with another dispatch or Shutdown.
+//
+// runCallbacksM must be locked outside serialize, or the executor might wait for a goroutine waiting for the executor.
+var runCallbacksM sync.Mutex
+
+// dispatchCallbacks runs the manual dispatch and returns the functions to invoke the handlers.
+func dispatchCallbacks() []func() {
+	runCallbacksM.Lock()
+	defer runCallbacksM.Unlock()
+
+	// Check the state under the lock, as Shutdown might have been called after the caller checked it.
+	if CurrentState() != StateInitialized {
+		return nil
+	}
+
+	var handlers []func()
+	serializeDo(func() {
+		pipe := ptrAPI_GetHSteamPipe()
+		ptrAPI_ManualDispatch_RunFrame(pipe)
+
+		var msg callbackMsg_t
+		for ptrAPI_ManualDispatch_GetNextCallback(pipe, uintptr(unsafe.Pointer(&msg))) {
+			var data []byte
+			if msg.m_pubParam != nil && msg.m_cubParam > 0 {
+				data = unsafe.Slice(msg.m_pubParam, msg.m_cubParam)
+			}
+			// SteamAPICallCompleted_t
+			if msg.m_iCallback == k_iSteamUtilsCallbacks+3 {
+				var c steamAPICallCompleted_t
+				if readStruct(data, &c) {
+					completeAPICall(pipe, &c)
+				}
+			}
+			handlers = append(handlers, decodeCallback(msg.m_iCallback, data)...)
+			ptrAPI_ManualDispatch_FreeLastCallback(pipe)
+		}
+	})
+	return handlers
+}
+
+// callAsync issues an asynchronous API call by f and returns the CallResult for it.
+//
+// The call is issued and registered under runCallbacksM.
+// Otherwise, a concurrent dispatch might receive the completion before the CallResult is registered, and the result would be lost.
+func callAsync[T any, PT callback[T]](f func() SteamAPICall_t) *CallResult[T] {
+	runCallbacksM.Lock()
+	defer runCallbacksM.Unlock()
+	return newCallResult[T, PT](serialize(f))
+}
+
+func completeAPICall(pipe HSteamPipe, c *steamAPICallCompleted_t) {
+	p := takePendingCall(c.m_hAsyncCall)
+	if p == nil {
+		return
+	}
+	if c.m_iCallback != p.callbackID || c.m_cubParam == 0 {
+		p.complete(nil, true)
+		return
+	}
+	data := make([]byte, c.m_cubParam)
+	var failed bool
+	if !ptrAPI_ManualDispatch_GetAPICallResult(pipe, c.m_hAsyncCall, uintptr(unsafe.Pointer(&data[0])), int32(len(data)), p.callbackID, uintptr(unsafe.Pointer(&failed))) {
+		p.complete(nil, true)
+		return
+	}
+	p.complete(data, failed)
+}
+
+// SteamApps returns the ISteamApps interface.
+//
+// The accessors like SteamApps never return nil.
+// They return the interfaces provided by the backend if it is set by SetBackend.
+// Otherwise, they return no-op implementations unless the state is StateInitialized, e.g., before Init, after Shutdown,
+// with the nosteam build tag or on the unsupported platforms.
+// The methods of the no-op implementations do nothing, and return zero values or ErrNotInitialized.
 func SteamApps() ISteamApps {
-	return steamApps(ptrAPI_SteamApps())
+	if b := currentBackend(); b != nil {
+		return b.SteamApps()
+	}
+	if CurrentState() != StateInitialized {
+		return noSteamApps{}
+	}
+	v := serialize(func() uintptr {
+		return ptrAPI_SteamApps()
+	})
+	if v == 0 {
+		return noSteamApps{}
+	}
+	return steamApps(v)
 }
 
 type steamApps uintptr
 
 func (s steamApps) BGetDLCDataByIndex(iDLC int) (appID AppId_t, available bool, pchName string, success bool) {
-	var name [4096]byte
-	v := ptrAPI_ISteamApps_BGetDLCDataByIndex(uintptr(s), int32(iDLC), uintptr(unsafe.Pointer(&appID)), uintptr(unsafe.Pointer(&available)), uintptr(unsafe.Pointer(&name[0])), int32(len(name)))
-	return appID, available, cStringToGo(name[:]), v
+	serializeDo(func() {
+		var name [4096]byte
+		success = ptrAPI_ISteamApps_BGetDLCDataByIndex(uintptr(s), int32(iDLC), uintptr(unsafe.Pointer(&appID)), uintptr(unsafe.Pointer(&available)), uintptr(unsafe.Pointer(&name[0])), int32(len(name)))
+		pchName = cStringToGo(name[:])
+	})
+	return
 }
 
 func (s steamApps) BIsDlcInstalled(appID AppId_t) bool {
-	return ptrAPI_ISteamApps_BIsDlcInstalled(uintptr(s), appID)
+	return serialize(func() bool {
+		return ptrAPI_ISteamApps_BIsDlcInstalled(uintptr(s), appID)
+	})
 }
 
 func (s steamApps) GetAppInstallDir(appID AppId_t) string {
-	var path [4096]byte
-	v := ptrAPI_ISteamApps_GetAppInstallDir(uintptr(s), appID, uintptr(unsafe.Pointer(&path[0])), int32(len(path)))
-	if v == 0 {
-		return ""
-	}
-	return string(path[:v-1])
+	return serialize(func() string {
+		var path [4096]byte
+		v := ptrAPI_ISteamApps_GetAppInstallDir(uintptr(s), appID, uintptr(unsafe.Pointer(&path[0])), int32(len(path)))
+		if v == 0 {
+			return ""
+		}
+		return string(path[:v-1])
+	})
 }
 
 func (s steamApps) GetCurrentGameLanguage() string {
-	return ptrAPI_ISteamApps_GetCurrentGameLanguage(uintptr(s))
+	return serialize(func() string {
+		return ptrAPI_ISteamApps_GetCurrentGameLanguage(uintptr(s))
+	})
 }
 
 func (s steamApps) GetDLCCount() int32 {
-	return ptrAPI_ISteamApps_GetDLCCount(uintptr(s))
+	return serialize(func() int32 {
+		return ptrAPI_ISteamApps_GetDLCCount(uintptr(s))
+	})
+}
+
+func (s steamApps) DLCs() iter.Seq[DLC] {
+	return func(yield func(DLC) bool) {
+		n := s.GetDLCCount()
+		for i := int32(0); i < n; i++ {
+			dlc := serialize(func() *DLC {
+				var dlc DLC
+				var name [4096]byte
+				if !ptrAPI_ISteamApps_BGetDLCDataByIndex(uintptr(s), i, uintptr(unsafe.Pointer(&dlc.AppID)), uintptr(unsafe.Pointer(&dlc.Available)), uintptr(unsafe.Pointer(&name[0])), int32(len(name))) {
+					return nil
+				}
+				dlc.Name = cStringToGo(name[:])
+				dlc.Installed = ptrAPI_ISteamApps_BIsDlcInstalled(uintptr(s), dlc.AppID)
+				return &dlc
+			})
+			if dlc == nil {
+				continue
+			}
+			if !yield(*dlc) {
+				return
+			}
+		}
+	}
+}
+
+func (s steamApps) GetNumBetas() (total, available, private int32) {
+	serializeDo(func() {
+		total = ptrAPI_ISteamApps_GetNumBetas(uintptr(s), uintptr(unsafe.Pointer(&available)), uintptr(unsafe.Pointer(&private)))
+	})
+	return
+}
+
+func (s steamApps) GetBetaInfo(index int32) (beta Beta, ok bool) {
+	serializeDo(func() {
+		var flags uint32
+		var name [256]byte
+		var desc [1024]byte
+		if !ptrAPI_ISteamApps_GetBetaInfo(uintptr(s), index, uintptr(unsafe.Pointer(&flags)), uintptr(unsafe.Pointer(&beta.BuildID)), uintptr(unsafe.Pointer(&name[0])), int32(len(name)), uintptr(unsafe.Pointer(&desc[0])), int32(len(desc))) {
+			beta = Beta{}
+			return
+		}
+		beta.Name = cStringToGo(name[:])
+		beta.Description = cStringToGo(desc[:])
+		beta.Flags = EBetaBranchFlags(flags)
+		ok = true
+	})
+	return
+}
+
+func (s steamApps) SetActiveBeta(name string) bool {
+	return serialize(func() bool {
+		return ptrAPI_ISteamApps_SetActiveBeta(uintptr(s), name)
+	})
+}
+
+func (s steamApps) InstallDLC(appID AppId_t) {
+	serializeDo(func() {
+		ptrAPI_ISteamApps_InstallDLC(uintptr(s), appID)
+	})
+}
+
+func (s steamApps) UninstallDLC(appID AppId_t) {
+	serializeDo(func() {
+		ptrAPI_ISteamApps_UninstallDLC(uintptr(s), appID)
+	})
+}
+
+func (s steamApps) GetDlcDownloadProgress(appID AppId_t) (bytesDownloaded, bytesTotal uint64, ok bool) {
+	serializeDo(func() {
+		ok = ptrAPI_ISteamApps_GetDlcDownloadProgress(uintptr(s), appID, uintptr(unsafe.Pointer(&bytesDownloaded)), uintptr(unsafe.Pointer(&bytesTotal)))
+	})
+	return
+}
+
+func (s steamApps) BIsAppInstalled(appID AppId_t) bool {
+	return serialize(func() bool {
+		return ptrAPI_ISteamApps_BIsAppInstalled(uintptr(s), appID)
+	})
+}
+
+func (s steamApps) BIsSubscribed() bool {
+	return serialize(func() bool {
+		return ptrAPI_ISteamApps_BIsSubscribed(uintptr(s))
+	})
+}
+
+func (s steamApps) BIsSubscribedApp(appID AppId_t) bool {
+	return serialize(func() bool {
+		return ptrAPI_ISteamApps_BIsSubscribedApp(uintptr(s), appID)
+	})
+}
+
+func (s steamApps) BIsLowViolence() bool {
+	return serialize(func() bool {
+		return ptrAPI_ISteamApps_BIsLowViolence(uintptr(s))
+	})
+}
+
+func (s steamApps) BIsCybercafe() bool {
+	return serialize(func() bool {
+		return ptrAPI_ISteamApps_BIsCybercafe(uintptr(s))
+	})
+}
+
+func (s steamApps) BIsVACBanned() bool {
+	return serialize(func() bool {
+		return ptrAPI_ISteamApps_BIsVACBanned(uintptr(s))
+	})
+}
+
+func (s steamApps) BIsSubscribedFromFreeWeekend() bool {
+	return serialize(func() bool {
+		return ptrAPI_ISteamApps_BIsSubscribedFromFreeWeekend(uintptr(s))
+	})
+}
+
+func (s steamApps) BIsSubscribedFromFamilySharing() bool {
+	return serialize(func() bool {
+		return ptrAPI_ISteamApps_BIsSubscribedFromFamilySharing(uintptr(s))
+	})
+}
+
+func (s steamApps) BIsTimedTrial() (allowed, played time.Duration, ok bool) {
+	serializeDo(func() {
+		var secondsAllowed, secondsPlayed uint32
+		ok = ptrAPI_ISteamApps_BIsTimedTrial(uintptr(s), uintptr(unsafe.Pointer(&secondsAllowed)), uintptr(unsafe.Pointer(&secondsPlayed)))
+		allowed = time.Duration(secondsAllowed) * time.Second
+		played = time.Duration(secondsPlayed) * time.Second
+	})
+	return
+}
+
+func (s steamApps) GetAppOwner() CSteamID {
+	return serialize(func() CSteamID {
+		return ptrAPI_ISteamApps_GetAppOwner(uintptr(s))
+	})
+}
+
+func (s steamApps) GetAppBuildId() int32 {
+	return serialize(func() int32 {
+		return ptrAPI_ISteamApps_GetAppBuildId(uintptr(s))
+	})
+}
+
+func (s steamApps) GetCurrentBetaName() (name string, ok bool) {
+	serializeDo(func() {
+		var buf [256]byte
+		ok = ptrAPI_ISteamApps_GetCurrentBetaName(uintptr(s), uintptr(unsafe.Pointer(&buf[0])), int32(len(buf)))
+		if ok {
+			name = cStringToGo(buf[:])
+		}
+	})
+	return
+}
+
+func (s steamApps) GetAvailableGameLanguages() []string {
+	v := serialize(func() string {
+		return ptrAPI_ISteamApps_GetAvailableGameLanguages(uintptr(s))
+	})
+	if v == "" {
+		return nil
+	}
+	return strings.Split(v, ",")
+}
+
+func (s steamApps) GetEarliestPurchaseUnixTime(appID AppId_t) time.Time {
+	v := serialize(func() uint32 {
+		return ptrAPI_ISteamApps_GetEarliestPurchaseUnixTime(uintptr(s), appID)
+	})
+	if v == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(v), 0)
+}
+
+func (s steamApps) GetLaunchQueryParam(key string) string {
+	return serialize(func() string {
+		return ptrAPI_ISteamApps_GetLaunchQueryParam(uintptr(s), key)
+	})
 }
 
+func (s steamApps) GetLaunchCommandLine() string {
+	return serialize(func() string {
+		var buf [4096]byte
+		v := ptrAPI_ISteamApps_GetLaunchCommandLine(uintptr(s), uintptr(unsafe.Pointer(&buf[0])), int32(len(buf)))
+		if v <= 0 {
+			return ""
+		}
+		return cStringToGo(buf[:])
+	})
+}
+
+// SteamFriends returns the ISteamFriends interface. See SteamApps for the no-op implementation.
 func SteamFriends() ISteamFriends {
-	return steamFriends(ptrAPI_SteamFriends())
+	if b := currentBackend(); b != nil {
+		return b.SteamFriends()
+	}
+	if CurrentState() != StateInitialized {
+		return noSteamFriends{}
+	}
+	v := serialize(func() uintptr {
+		return ptrAPI_SteamFriends()
+	})
+	if v == 0 {
+		return noSteamFriends{}
+	}
+	return steamFriends(v)
 }
 
 type steamFriends uintptr
 
 func (s steamFriends) GetPersonaName() string {
-	return ptrAPI_ISteamFriends_GetPersonaName(uintptr(s))
+	return serialize(func() string {
+		return ptrAPI_ISteamFriends_GetPersonaName(uintptr(s))
+	})
 }
 
 func (s steamFriends) SetRichPresence(key, value string) bool {
-	return ptrAPI_ISteamFriends_SetRichPresence(uintptr(s), key, value)
+	return serialize(func() bool {
+		return ptrAPI_ISteamFriends_SetRichPresence(uintptr(s), key, value)
+	})
+}
+
+func (s steamFriends) ActivateGameOverlay(dialog GameOverlayDialog) {
+	serializeDo(func() {
+		ptrAPI_ISteamFriends_ActivateGameOverlay(uintptr(s), string(dialog))
+	})
+}
+
+func (s steamFriends) ActivateGameOverlayToUser(dialog GameOverlayUserDialog, user CSteamID) {
+	serializeDo(func() {
+		ptrAPI_ISteamFriends_ActivateGameOverlayToUser(uintptr(s), string(dialog), user)
+	})
+}
+
+func (s steamFriends) ActivateGameOverlayToWebPage(url string, mode EActivateGameOverlayToWebPageMode) {
+	serializeDo(func() {
+		ptrAPI_ISteamFriends_ActivateGameOverlayToWebPage(uintptr(s), url, mode)
+	})
+}
+
+func (s steamFriends) ActivateGameOverlayToStore(appID AppId_t, flag EOverlayToStoreFlag) {
+	serializeDo(func() {
+		ptrAPI_ISteamFriends_ActivateGameOverlayToStore(uintptr(s), appID, flag)
+	})
+}
+
+func (s steamFriends) ActivateGameOverlayInviteDialog(lobby CSteamID) {
+	serializeDo(func() {
+		ptrAPI_ISteamFriends_ActivateGameOverlayInviteDialog(uintptr(s), lobby)
+	})
+}
+
+func (s steamFriends) ActivateGameOverlayInviteDialogConnectString(connectString string) {
+	serializeDo(func() {
+		ptrAPI_ISteamFriends_ActivateGameOverlayInviteDialogConnectString(uintptr(s), connectString)
+	})
+}
+
+func (s steamFriends) ClearRichPresence() {
+	serializeDo(func() {
+		ptrAPI_ISteamFriends_ClearRichPresence(uintptr(s))
+	})
+}
+
+func (s steamFriends) GetFriendRichPresence(friend CSteamID, key string) string {
+	return serialize(func() string {
+		return ptrAPI_ISteamFriends_GetFriendRichPresence(uintptr(s), friend, key)
+	})
+}
+
+func (s steamFriends) GetFriendRichPresenceKeyCount(friend CSteamID) int32 {
+	return serialize(func() int32 {
+		return ptrAPI_ISteamFriends_GetFriendRichPresenceKeyCount(uintptr(s), friend)
+	})
+}
+
+func (s steamFriends) GetFriendRichPresenceKeyByIndex(friend CSteamID, index int32) string {
+	return serialize(func() string {
+		return ptrAPI_ISteamFriends_GetFriendRichPresenceKeyByIndex(uintptr(s), friend, index)
+	})
+}
+
+func (s steamFriends) RequestFriendRichPresence(friend CSteamID) {
+	serializeDo(func() {
+		ptrAPI_ISteamFriends_RequestFriendRichPresence(uintptr(s), friend)
+	})
+}
+
+func (s steamFriends) GetFriendCount(flags EFriendFlags) int32 {
+	return serialize(func() int32 {
+		return ptrAPI_ISteamFriends_GetFriendCount(uintptr(s), flags)
+	})
+}
+
+func (s steamFriends) GetFriendByIndex(index int32, flags EFriendFlags) CSteamID {
+	return serialize(func() CSteamID {
+		return ptrAPI_ISteamFriends_GetFriendByIndex(uintptr(s), index, flags)
+	})
+}
+
+func (s steamFriends) GetFriendPersonaName(friend CSteamID) string {
+	return serialize(func() string {
+		return ptrAPI_ISteamFriends_GetFriendPersonaName(uintptr(s), friend)
+	})
+}
+
+func (s steamFriends) GetFriendPersonaState(friend CSteamID) EPersonaState {
+	return serialize(func() EPersonaState {
+		return ptrAPI_ISteamFriends_GetFriendPersonaState(uintptr(s), friend)
+	})
+}
+
+func (s steamFriends) GetFriendRelationship(friend CSteamID) EFriendRelationship {
+	return serialize(func() EFriendRelationship {
+		return ptrAPI_ISteamFriends_GetFriendRelationship(uintptr(s), friend)
+	})
+}
+
+func (s steamFriends) GetFriendGamePlayed(friend CSteamID) (info FriendGameInfo, ok bool) {
+	serializeDo(func() {
+		var v friendGameInfo_t
+		if !ptrAPI_ISteamFriends_GetFriendGamePlayed(uintptr(s), friend, uintptr(unsafe.Pointer(&v))) {
+			return
+		}
+		info = v.toGo()
+		ok = true
+	})
+	return
+}
+
+func (s steamFriends) GetPlayerNickname(player CSteamID) string {
+	return serialize(func() string {
+		return ptrAPI_ISteamFriends_GetPlayerNickname(uintptr(s), player)
+	})
+}
+
+func (s steamFriends) GetFriendSteamLevel(friend CSteamID) int32 {
+	return serialize(func() int32 {
+		return ptrAPI_ISteamFriends_GetFriendSteamLevel(uintptr(s), friend)
+	})
 }
 
+func (s steamFriends) Avatar(ctx context.Context, friend CSteamID, size AvatarSize) (*image.RGBA, error) {
+	var getAvatar func(uintptr, CSteamID) int32
+	switch size {
+	case AvatarSizeSmall:
+		getAvatar = ptrAPI_ISteamFriends_GetSmallFriendAvatar
+	case AvatarSizeMedium:
+		getAvatar = ptrAPI_ISteamFriends_GetMediumFriendAvatar
+	case AvatarSizeLarge:
+		getAvatar = ptrAPI_ISteamFriends_GetLargeFriendAvatar
+	default:
+		return nil, fmt.Errorf("steamworks: invalid avatar size: %d", size)
+	}
+
+	// Register the handler before getting the image handle so that the callback is not missed.
+	loaded := make(chan struct{}, 1)
+	unregister := OnCallback(func(a AvatarImageLoaded) {
+		if a.SteamID != friend {
+			return
+		}
+		select {
+		case loaded <- struct{}{}:
+		default:
+		}
+	})
+	defer unregister()
+
+	var requested bool
+	for {
+		var handle int32
+		img := serialize(func() *image.RGBA {
+			handle = getAvatar(uintptr(s), friend)
+			if handle <= 0 {
+				return nil
+			}
+			return imageRGBA(ptrAPI_SteamUtils(), handle)
+		})
+		if img != nil {
+			return img, nil
+		}
+
+		switch {
+		case handle == 0:
+			return nil, ErrNoAvatar
+		case handle > 0:
+			return nil, fmt.Errorf("steamworks: failed to get the avatar image %d", handle)
+		}
+
+		// The avatar is not loaded yet.
+		if !requested {
+			serializeDo(func() {
+				ptrAPI_ISteamFriends_RequestUserInformation(uintptr(s), friend, false)
+			})
+			requested = true
+		}
+		select {
+		case <-loaded:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// imageRGBA returns the image of the handle.
+// imageRGBA returns nil if the image is not available.
+func imageRGBA(utils uintptr, handle int32) *image.RGBA {
+	if utils == 0 {
+		return nil
+	}
+	var width, height uint32
+	if !ptrAPI_ISteamUtils_GetImageSize(utils, handle, uintptr(unsafe.Pointer(&width)), uintptr(unsafe.Pointer(&height))) {
+		return nil
+	}
+	img := image.NewRGBA(image.Rect(0, 0, int(width), int(height)))
+	if len(img.Pix) == 0 {
+		return img
+	}
+	if !ptrAPI_ISteamUtils_GetImageRGBA(utils, handle, uintptr(unsafe.Pointer(&img.Pix[0])), int32(len(img.Pix))) {
+		return nil
+	}
+	return img
+}
+
+func (s steamFriends) Friends(flags EFriendFlags) iter.Seq[Friend] {
+	return func(yield func(Friend) bool) {
+		n := s.GetFriendCount(flags)
+		for i := int32(0); i < n; i++ {
+			friend := serialize(func() *Friend {
+				id := ptrAPI_ISteamFriends_GetFriendByIndex(uintptr(s), i, flags)
+				if !id.IsValid() {
+					return nil
+				}
+				f := &Friend{
+					SteamID:      id,
+					PersonaName:  ptrAPI_ISteamFriends_GetFriendPersonaName(uintptr(s), id),
+					Nickname:     ptrAPI_ISteamFriends_GetPlayerNickname(uintptr(s), id),
+					PersonaState: ptrAPI_ISteamFriends_GetFriendPersonaState(uintptr(s), id),
+					Relationship: ptrAPI_ISteamFriends_GetFriendRelationship(uintptr(s), id),
+					SteamLevel:   ptrAPI_ISteamFriends_GetFriendSteamLevel(uintptr(s), id),
+				}
+				var game friendGameInfo_t
+				if ptrAPI_ISteamFriends_GetFriendGamePlayed(uintptr(s), id, uintptr(unsafe.Pointer(&game))) {
+					f.InGame = true
+					f.Game = game.toGo()
+				}
+				return f
+			})
+			if friend == nil {
+				continue
+			}
+			if !yield(*friend) {
+				return
+			}
+		}
+	}
+}
+
+// SteamInput returns the ISteamInput interface. See SteamApps for the no-op implementation.
 func SteamInput() ISteamInput {
-	return steamInput(ptrAPI_SteamInput())
+	if b := currentBackend(); b != nil {
+		return b.SteamInput()
+	}
+	if CurrentState() != StateInitialized {
+		return noSteamInput{}
+	}
+	v := serialize(func() uintptr {
+		return ptrAPI_SteamInput()
+	})
+	if v == 0 {
+		return noSteamInput{}
+	}
+	return steamInput(v)
 }
 
 type steamInput uintptr
 
 func (s steamInput) GetConnectedControllers() []InputHandle_t {
-	var handles [_STEAM_INPUT_MAX_COUNT]InputHandle_t
-	v := ptrAPI_ISteamInput_GetConnectedControllers(uintptr(s), uintptr(unsafe.Pointer(&handles[0])))
-	return handles[:int(v)]
+	return serialize(func() []InputHandle_t {
+		var handles [_STEAM_INPUT_MAX_COUNT]InputHandle_t
+		v := ptrAPI_ISteamInput_GetConnectedControllers(uintptr(s), uintptr(unsafe.Pointer(&handles[0])))
+		return handles[:int(v)]
+	})
 }
 
 func (s steamInput) GetInputTypeForHandle(inputHandle InputHandle_t) ESteamInputType {
-	v := ptrAPI_ISteamInput_GetInputTypeForHandle(uintptr(s), inputHandle)
-	return ESteamInputType(v)
+	return serialize(func() ESteamInputType {
+		return ESteamInputType(ptrAPI_ISteamInput_GetInputTypeForHandle(uintptr(s), inputHandle))
+	})
 }
 
 func (s steamInput) Init(bExplicitlyCallRunFrame bool) bool {
-	return ptrAPI_ISteamInput_Init(uintptr(s), bExplicitlyCallRunFrame)
+	return serialize(func() bool {
+		return ptrAPI_ISteamInput_Init(uintptr(s), bExplicitlyCallRunFrame)
+	})
 }
 
 func (s steamInput) RunFrame() {
-	ptrAPI_ISteamInput_RunFrame(uintptr(s), false)
+	serializeDo(func() {
+		ptrAPI_ISteamInput_RunFrame(uintptr(s), false)
+	})
 }
 
+// SteamRemoteStorage returns the ISteamRemoteStorage interface. See SteamApps for the no-op implementation.
 func SteamRemoteStorage() ISteamRemoteStorage {
-	return steamRemoteStorage(ptrAPI_SteamRemoteStorage())
+	if b := currentBackend(); b != nil {
+		return b.SteamRemoteStorage()
+	}
+	if CurrentState() != StateInitialized {
+		return noSteamRemoteStorage{}
+	}
+	v := serialize(func() uintptr {
+		return ptrAPI_SteamRemoteStorage()
+	})
+	if v == 0 {
+		return noSteamRemoteStorage{}
+	}
+	return steamRemoteStorage(v)
 }
 
 type steamRemoteStorage uintptr
 
 func (s steamRemoteStorage) FileWrite(file string, data []byte) bool {
-	return ptrAPI_ISteamRemoteStorage_FileWrite(uintptr(s), file, uintptr(unsafe.Pointer(&data[0])), int32(len(data)))
+	return serialize(func() bool {
+		return ptrAPI_ISteamRemoteStorage_FileWrite(uintptr(s), file, uintptr(unsafe.Pointer(&data[0])), int32(len(data)))
+	})
+}
+
+func (s steamRemoteStorage) FileWriteAsync(file string, data []byte) *CallResult[RemoteStorageFileWriteAsyncComplete] {
+	return callAsync[RemoteStorageFileWriteAsyncComplete](func() SteamAPICall_t {
+		return ptrAPI_ISteamRemoteStorage_FileWriteAsync(uintptr(s), file, uintptr(unsafe.Pointer(unsafe.SliceData(data))), uint32(len(data)))
+	})
 }
 
 func (s steamRemoteStorage) FileRead(file string, data []byte) int32 {
-	return ptrAPI_ISteamRemoteStorage_FileRead(uintptr(s), file, uintptr(unsafe.Pointer(&data[0])), int32(len(data)))
+	return serialize(func() int32 {
+		return ptrAPI_ISteamRemoteStorage_FileRead(uintptr(s), file, uintptr(unsafe.Pointer(&data[0])), int32(len(data)))
+	})
 }
 
 func (s steamRemoteStorage) FileDelete(file string) bool {
-	return ptrAPI_ISteamRemoteStorage_FileDelete(uintptr(s), file)
+	return serialize(func() bool {
+		return ptrAPI_ISteamRemoteStorage_FileDelete(uintptr(s), file)
+	})
 }
 
 func (s steamRemoteStorage) GetFileSize(file string) int32 {
-	return ptrAPI_ISteamRemoteStorage_GetFileSize(uintptr(s), file)
+	return serialize(func() int32 {
+		return ptrAPI_ISteamRemoteStorage_GetFileSize(uintptr(s), file)
+	})
 }
 
+// SteamUser returns the ISteamUser interface. See SteamApps for the no-op implementation.
 func SteamUser() ISteamUser {
-	return steamUser(ptrAPI_SteamUser())
+	if b := currentBackend(); b != nil {
+		return b.SteamUser()
+	}
+	if CurrentState() != StateInitialized {
+		return noSteamUser{}
+	}
+	v := serialize(func() uintptr {
+		return ptrAPI_SteamUser()
+	})
+	if v == 0 {
+		return noSteamUser{}
+	}
+	return steamUser(v)
 }
 
 type steamUser uintptr
 
 func (s steamUser) GetSteamID() CSteamID {
-	return CSteamID(ptrAPI_ISteamUser_GetSteamID(uintptr(s)))
+	return serialize(func() CSteamID {
+		return CSteamID(ptrAPI_ISteamUser_GetSteamID(uintptr(s)))
+	})
 }
 
+// SteamUserStats returns the ISteamUserStats interface. See SteamApps for the no-op implementation.
 func SteamUserStats() ISteamUserStats {
-	return steamUserStats(ptrAPI_SteamUserStats())
+	if b := currentBackend(); b != nil {
+		return b.SteamUserStats()
+	}
+	if CurrentState() != StateInitialized {
+		return noSteamUserStats{}
+	}
+	v := serialize(func() uintptr {
+		return ptrAPI_SteamUserStats()
+	})
+	if v == 0 {
+		return noSteamUserStats{}
+	}
+	return steamUserStats(v)
 }
 
 type steamUserStats uintptr
 
 func (s steamUserStats) GetAchievement(name string) (achieved, success bool) {
-	success = ptrAPI_ISteamUserStats_GetAchievement(uintptr(s), name, uintptr(unsafe.Pointer(&achieved)))
+	serializeDo(func() {
+		success = ptrAPI_ISteamUserStats_GetAchievement(uintptr(s), name, uintptr(unsafe.Pointer(&achieved)))
+	})
 	return
 }
 
 func (s steamUserStats) SetAchievement(name string) bool {
-	return ptrAPI_ISteamUserStats_SetAchievement(uintptr(s), name)
+	return serialize(func() bool {
+		return ptrAPI_ISteamUserStats_SetAchievement(uintptr(s), name)
+	})
 }
 
 func (s steamUserStats) ClearAchievement(name string) bool {
-	return ptrAPI_ISteamUserStats_ClearAchievement(uintptr(s), name)
+	return serialize(func() bool {
+		return ptrAPI_ISteamUserStats_ClearAchievement(uintptr(s), name)
+	})
 }
 
 func (s steamUserStats) StoreStats() bool {
-	return ptrAPI_ISteamUserStats_StoreStats(uintptr(s))
+	return serialize(func() bool {
+		return ptrAPI_ISteamUserStats_StoreStats(uintptr(s))
+	})
 }
 
+// SteamUtils returns the ISteamUtils interface. See SteamApps for the no-op implementation.
 func SteamUtils() ISteamUtils {
-	return steamUtils(ptrAPI_SteamUtils())
+	if b := currentBackend(); b != nil {
+		return b.SteamUtils()
+	}
+	if CurrentState() != StateInitialized {
+		return noSteamUtils{}
+	}
+	v := serialize(func() uintptr {
+		return ptrAPI_SteamUtils()
+	})
+	if v == 0 {
+		return noSteamUtils{}
+	}
+	return steamUtils(v)
 }
 
 type steamUtils uintptr
 
+func (s steamUtils) BOverlayNeedsPresent() bool {
+	return serialize(func() bool {
+		return ptrAPI_ISteamUtils_BOverlayNeedsPresent(uintptr(s))
+	})
+}
+
+func (s steamUtils) SetOverlayNotificationPosition(notificationPosition ENotificationPosition) {
+	serializeDo(func() {
+		ptrAPI_ISteamUtils_SetOverlayNotificationPosition(uintptr(s), notificationPosition)
+	})
+}
+
+func (s steamUtils) SetOverlayNotificationInset(horizontalInset, verticalInset int32) {
+	serializeDo(func() {
+		ptrAPI_ISteamUtils_SetOverlayNotificationInset(uintptr(s), horizontalInset, verticalInset)
+	})
+}
+
+func (s steamUtils) GetImageSize(handle int32) (width, height uint32, ok bool) {
+	serializeDo(func() {
+		ok = ptrAPI_ISteamUtils_GetImageSize(uintptr(s), handle, uintptr(unsafe.Pointer(&width)), uintptr(unsafe.Pointer(&height)))
+	})
+	return
+}
+
+func (s steamUtils) GetImageRGBA(handle int32) ([]byte, bool) {
+	img := serialize(func() *image.RGBA {
+		return imageRGBA(uintptr(s), handle)
+	})
+	if img == nil {
+		return nil, false
+	}
+	return img.Pix, true
+}
+
 func (s steamUtils) IsOverlayEnabled() bool {
-	return ptrAPI_ISteamUtils_IsOverlayEnabled(uintptr(s))
+	return serialize(func() bool {
+		return ptrAPI_ISteamUtils_IsOverlayEnabled(uintptr(s))
+	})
 }
 
 func (s steamUtils) IsSteamRunningOnSteamDeck() bool {
-	return ptrAPI_ISteamUtils_IsSteamRunningOnSteamDeck(uintptr(s))
+	return serialize(func() bool {
+		return ptrAPI_ISteamUtils_IsSteamRunningOnSteamDeck(uintptr(s))
+	})
 }
 
 func (s steamUtils) ShowFloatingGamepadTextInput(keyboardMode EFloatingGamepadTextInputMode, textFieldXPosition, textFieldYPosition, textFieldWidth, textFieldHeight int32) bool {
-	return ptrAPI_ISteamUtils_ShowFloatingGamepadTextInput(uintptr(s), keyboardMode, textFieldXPosition, textFieldYPosition, textFieldWidth, textFieldHeight)
+	return serialize(func() bool {
+		return ptrAPI_ISteamUtils_ShowFloatingGamepadTextInput(uintptr(s), keyboardMode, textFieldXPosition, textFieldYPosition, textFieldWidth, textFieldHeight)
+	})
 }
 
-func cStringToGo(name []byte) string {
-	idx := bytes.IndexByte(name, 0)
-	if idx < 0 {
-		return string(name)
-	}
-	return string(name[:idx])
+// Assert the layout of callbackMsg_t filled by the library at compile time.
+func _() {
+	var x [1]struct{}
+
+	_ = x[unsafe.Offsetof(callbackMsg_t{}.m_iCallback)-4]
+	_ = x[unsafe.Offsetof(callbackMsg_t{}.m_pubParam)-8]
+	_ = x[unsafe.Offsetof(callbackMsg_t{}.m_cubParam)-16]
+
+	// The size is 20 with the packing by 4 bytes, and 24 with the packing by 8 bytes.
+	_ = x[unsafe.Sizeof(callbackMsg_t{}.m_cubParam)+unsafe.Offsetof(callbackMsg_t{}.m_cubParam)-20]
 }
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"sync/atomic"
+)
+
+// Backend provides the interfaces returned by the accessors like SteamApps.
+//
+// Backend is used to replace Steam with another implementation, e.g., the steamworkstest package for testing.
+// A Backend can also provide the interfaces generated by gen.go by implementing the accessors as methods,
+// e.g., SteamScreenshots() ISteamScreenshots. Otherwise, such accessors return the no-op implementations while the Backend is set.
+type Backend interface {
+	SteamApps() ISteamApps
+	SteamFriends() ISteamFriends
+	SteamInput() ISteamInput
+	SteamRemoteStorage() ISteamRemoteStorage
+	SteamUser() ISteamUser
+	SteamUserStats() ISteamUserStats
+	SteamUtils() ISteamUtils
+}
+
+var theBackend atomic.Pointer[Backend]
+
+// SetBackend makes the accessors like SteamApps return the interfaces provided by b instead of Steam's.
+// If b is nil, the accessors return Steam's interfaces.
+//
+// SetBackend returns a function to restore the previous backend.
+func SetBackend(b Backend) (restore func()) {
+	var p *Backend
+	if b != nil {
+		p = &b
+	}
+	old := theBackend.Swap(p)
+	return func() {
+		theBackend.Store(old)
+	}
+}
+
+func currentBackend() Backend {
+	p := theBackend.Load()
+	if p == nil {
+		return nil
+	}
+	return *p
+}
@@ -0,0 +1,413 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"bytes"
+	"sync"
+	"unsafe"
+)
+
+// callback is implemented by pointers to the types that can be received with OnCallback.
+type callback[T any] interface {
+	*T
+	callbackID() int32
+	decode(data []byte) bool
+}
+
+type callbackHandler struct {
+	// handle decodes the callback data and returns a function to invoke the handler.
+	// handle returns nil if the data cannot be decoded.
+	handle func(data []byte) func()
+
+	// post invokes the handler with a decoded value.
+	post func(v any)
+}
+
+var (
+	callbackHandlers  = map[int32][]*callbackHandler{}
+	callbackHandlersM sync.Mutex
+)
+
+// OnCallback registers f to be called with every callback of type T.
+//
+// f is called from RunCallbacks, on the goroutine calling RunCallbacks.
+//
+// OnCallback returns a function to unregister f.
+func OnCallback[T any, PT callback[T]](f func(T)) (unregister func()) {
+	id := PT(nil).callbackID()
+	h := &callbackHandler{
+		handle: func(data []byte) func() {
+			var v T
+			if !PT(&v).decode(data) {
+				return nil
+			}
+			return func() {
+				f(v)
+			}
+		},
+		post: func(v any) {
+			f(v.(T))
+		},
+	}
+
+	callbackHandlersM.Lock()
+	defer callbackHandlersM.Unlock()
+	callbackHandlers[id] = append(callbackHandlers[id], h)
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			callbackHandlersM.Lock()
+			defer callbackHandlersM.Unlock()
+			hs := callbackHandlers[id]
+			for i, h2 := range hs {
+				if h2 != h {
+					continue
+				}
+				// Copy the slice so that a slice being dispatched is not modified.
+				hs = append(hs[:i:i], hs[i+1:]...)
+				break
+			}
+			if len(hs) == 0 {
+				delete(callbackHandlers, id)
+				return
+			}
+			callbackHandlers[id] = hs
+		})
+	}
+}
+
+// PostCallback calls the handlers registered by OnCallback for the type of v, on the calling goroutine.
+//
+// PostCallback is useful to implement the interfaces without Steam, e.g., for testing.
+func PostCallback[T any, PT callback[T]](v T) {
+	id := PT(nil).callbackID()
+
+	callbackHandlersM.Lock()
+	hs := callbackHandlers[id]
+	callbackHandlersM.Unlock()
+
+	for _, h := range hs {
+		h.post(v)
+	}
+}
+
+// decodeCallback decodes the data of a callback with the given ID.
+// decodeCallback returns functions to invoke the handlers. The data is not referred after decodeCallback returns.
+func decodeCallback(id int32, data []byte) []func() {
+	callbackHandlersM.Lock()
+	hs := callbackHandlers[id]
+	callbackHandlersM.Unlock()
+
+	var fs []func()
+	for _, h := range hs {
+		if f := h.handle(data); f != nil {
+			fs = append(fs, f)
+		}
+	}
+	return fs
+}
+
+// readStruct copies the C struct at the head of data into a value of type T.
+// readStruct returns false if data is too short.
+func readStruct[T any](data []byte, v *T) bool {
+	if uintptr(len(data)) < unsafe.Sizeof(*v) {
+		return false
+	}
+	if unsafe.Sizeof(*v) == 0 {
+		return true
+	}
+	*v = *(*T)(unsafe.Pointer(&data[0]))
+	return true
+}
+
+// cStringToGo converts a NUL-terminated C string in name into a Go string.
+func cStringToGo(name []byte) string {
+	idx := bytes.IndexByte(name, 0)
+	if idx < 0 {
+		return string(name)
+	}
+	return string(name[:idx])
+}
+
+// GameOverlayActivated is posted when the Steam overlay is activated or deactivated.
+type GameOverlayActivated struct {
+	// Active reports whether the overlay has just been activated.
+	Active bool
+
+	// UserInitiated reports whether the user asked for the overlay to be activated or deactivated.
+	UserInitiated bool
+
+	// AppID is the app ID of the game.
+	AppID AppId_t
+}
+
+type gameOverlayActivated_t struct {
+	m_bActive        uint8
+	m_bUserInitiated bool
+	m_nAppID         AppId_t
+	m_dwOverlayPID   uint32
+}
+
+func (*GameOverlayActivated) callbackID() int32 {
+	return k_iSteamFriendsCallbacks + 31
+}
+
+func (g *GameOverlayActivated) decode(data []byte) bool {
+	var c gameOverlayActivated_t
+	if !readStruct(data, &c) {
+		return false
+	}
+	*g = GameOverlayActivated{
+		Active:        c.m_bActive != 0,
+		UserInitiated: c.m_bUserInitiated,
+		AppID:         c.m_nAppID,
+	}
+	return true
+}
+
+// AvatarImageLoaded is posted when an avatar that was not loaded yet is loaded.
+type AvatarImageLoaded struct {
+	// SteamID is the user whose avatar is loaded.
+	SteamID CSteamID
+
+	// Image is the image handle for ISteamUtils.GetImageSize and ISteamUtils.GetImageRGBA.
+	Image int32
+
+	// Width and Height are the size of the image.
+	Width  int32
+	Height int32
+}
+
+type avatarImageLoaded_t struct {
+	m_steamID callbackUint64
+	m_iImage  int32
+	m_iWide   int32
+	m_iTall   int32
+}
+
+func (*AvatarImageLoaded) callbackID() int32 {
+	return k_iSteamFriendsCallbacks + 34
+}
+
+func (a *AvatarImageLoaded) decode(data []byte) bool {
+	var c avatarImageLoaded_t
+	if !readStruct(data, &c) {
+		return false
+	}
+	*a = AvatarImageLoaded{
+		SteamID: CSteamID(c.m_steamID.get()),
+		Image:   c.m_iImage,
+		Width:   c.m_iWide,
+		Height:  c.m_iTall,
+	}
+	return true
+}
+
+// FriendRichPresenceUpdate is posted when the rich presence of a friend is updated,
+// e.g., after ISteamFriends.RequestFriendRichPresence.
+type FriendRichPresenceUpdate struct {
+	// SteamIDFriend is the friend whose rich presence is updated.
+	SteamIDFriend CSteamID
+
+	// AppID is the app ID of the game that the friend is playing.
+	AppID AppId_t
+}
+
+type friendRichPresenceUpdate_t struct {
+	m_steamIDFriend callbackUint64
+	m_nAppID        AppId_t
+}
+
+func (*FriendRichPresenceUpdate) callbackID() int32 {
+	return k_iSteamFriendsCallbacks + 36
+}
+
+func (f *FriendRichPresenceUpdate) decode(data []byte) bool {
+	var c friendRichPresenceUpdate_t
+	if !readStruct(data, &c) {
+		return false
+	}
+	*f = FriendRichPresenceUpdate{
+		SteamIDFriend: CSteamID(c.m_steamIDFriend.get()),
+		AppID:         c.m_nAppID,
+	}
+	return true
+}
+
+// SteamShutdown is posted when Steam wants to shut down.
+type SteamShutdown struct{}
+
+func (*SteamShutdown) callbackID() int32 {
+	return k_iSteamUtilsCallbacks + 4
+}
+
+func (*SteamShutdown) decode(data []byte) bool {
+	return true
+}
+
+// FloatingGamepadTextInputDismissed is posted when the floating keyboard invoked from ShowFloatingGamepadTextInput has been closed.
+type FloatingGamepadTextInputDismissed struct{}
+
+func (*FloatingGamepadTextInputDismissed) callbackID() int32 {
+	return k_iSteamUtilsCallbacks + 38
+}
+
+func (*FloatingGamepadTextInputDismissed) decode(data []byte) bool {
+	return true
+}
+
+// DlcInstalled is posted after the user gains ownership of DLC and that DLC is installed.
+type DlcInstalled struct {
+	// AppID is the app ID of the DLC that was installed.
+	AppID AppId_t
+}
+
+type dlcInstalled_t struct {
+	m_nAppID AppId_t
+}
+
+func (*DlcInstalled) callbackID() int32 {
+	return k_iSteamAppsCallbacks + 5
+}
+
+func (d *DlcInstalled) decode(data []byte) bool {
+	var c dlcInstalled_t
+	if !readStruct(data, &c) {
+		return false
+	}
+	*d = DlcInstalled{
+		AppID: c.m_nAppID,
+	}
+	return true
+}
+
+// UserStatsReceived is posted when the latest stats and achievements of a user are received from the server.
+type UserStatsReceived struct {
+	// GameID is the game ID that the stats are for.
+	GameID uint64
+
+	// Result is the result of the request.
+	Result EResult
+
+	// SteamIDUser is the user whose stats are retrieved.
+	SteamIDUser CSteamID
+}
+
+type userStatsReceived_t struct {
+	m_nGameID     callbackUint64
+	m_eResult     EResult
+	m_steamIDUser callbackUint64
+}
+
+func (*UserStatsReceived) callbackID() int32 {
+	return k_iSteamUserStatsCallbacks + 1
+}
+
+func (u *UserStatsReceived) decode(data []byte) bool {
+	var c userStatsReceived_t
+	if !readStruct(data, &c) {
+		return false
+	}
+	*u = UserStatsReceived{
+		GameID:      c.m_nGameID.get(),
+		Result:      c.m_eResult,
+		SteamIDUser: CSteamID(c.m_steamIDUser.get()),
+	}
+	return true
+}
+
+// UserStatsStored is posted as the result of ISteamUserStats.StoreStats.
+type UserStatsStored struct {
+	// GameID is the game ID that the stats are for.
+	GameID uint64
+
+	// Result is the result of the request.
+	Result EResult
+}
+
+type userStatsStored_t struct {
+	m_nGameID callbackUint64
+	m_eResult EResult
+}
+
+func (*UserStatsStored) callbackID() int32 {
+	return k_iSteamUserStatsCallbacks + 2
+}
+
+func (u *UserStatsStored) decode(data []byte) bool {
+	var c userStatsStored_t
+	if !readStruct(data, &c) {
+		return false
+	}
+	*u = UserStatsStored{
+		GameID: c.m_nGameID.get(),
+		Result: c.m_eResult,
+	}
+	return true
+}
+
+// UserAchievementStored is posted as the result of ISteamUserStats.StoreStats when an achievement is unlocked.
+type UserAchievementStored struct {
+	// GameID is the game ID that the achievement is for.
+	GameID uint64
+
+	// GroupAchievement reports whether the achievement is a group achievement.
+	GroupAchievement bool
+
+	// AchievementName is the API name of the achievement.
+	AchievementName string
+
+	// CurProgress is the current progress towards the achievement. CurProgress is 0 if the achievement is unlocked.
+	CurProgress uint32
+
+	// MaxProgress is the total progress to unlock the achievement. MaxProgress is 0 if the achievement is unlocked.
+	MaxProgress uint32
+}
+
+type userAchievementStored_t struct {
+	m_nGameID             callbackUint64
+	m_bGroupAchievement   bool
+	m_rgchAchievementName [k_cchStatNameMax]byte
+	m_nCurProgress        uint32
+	m_nMaxProgress        uint32
+}
+
+func (*UserAchievementStored) callbackID() int32 {
+	return k_iSteamUserStatsCallbacks + 3
+}
+
+func (u *UserAchievementStored) decode(data []byte) bool {
+	var c userAchievementStored_t
+	if !readStruct(data, &c) {
+		return false
+	}
+	*u = UserAchievementStored{
+		GameID:           c.m_nGameID.get(),
+		GroupAchievement: c.m_bGroupAchievement,
+		AchievementName:  cStringToGo(c.m_rgchAchievementName[:]),
+		CurProgress:      c.m_nCurProgress,
+		MaxProgress:      c.m_nMaxProgress,
+	}
+	return true
+}
+
+// Assert the layouts of the callback structs that are the same on all the platforms at compile time.
+func _() {
+	var x [1]struct{}
+
+	_ = x[unsafe.Sizeof(gameOverlayActivated_t{})-12]
+	_ = x[unsafe.Offsetof(gameOverlayActivated_t{}.m_bUserInitiated)-1]
+	_ = x[unsafe.Offsetof(gameOverlayActivated_t{}.m_nAppID)-4]
+	_ = x[unsafe.Offsetof(gameOverlayActivated_t{}.m_dwOverlayPID)-8]
+
+	_ = x[unsafe.Sizeof(dlcInstalled_t{})-4]
+
+	_ = x[unsafe.Sizeof(steamAPICallCompleted_t{})-16]
+	_ = x[unsafe.Offsetof(steamAPICallCompleted_t{}.m_iCallback)-8]
+	_ = x[unsafe.Offsetof(steamAPICallCompleted_t{}.m_cubParam)-12]
+
+	_ = x[unsafe.Sizeof(remoteStorageFileWriteAsyncComplete_t{})-4]
+}
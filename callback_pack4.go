@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+//go:build !windows || !(amd64 || arm64)
+
+package steamworks
+
+import (
+	"unsafe"
+)
+
+// callbackUint64 is a 64-bit integer in a callback struct.
+//
+// On Linux and macOS, callback structs are packed by 4 bytes (VALVE_CALLBACK_PACK_SMALL),
+// so a 64-bit integer is aligned to 4 bytes unlike Go's uint64.
+//
+// This is also used on 32-bit Windows, which is not supported, only to compile this package.
+type callbackUint64 [2]uint32
+
+func (c callbackUint64) get() uint64 {
+	return uint64(c[0]) | uint64(c[1])<<32
+}
+
+// Assert the layouts of the callback structs packed by 4 bytes at compile time.
+func _() {
+	var x [1]struct{}
+
+	_ = x[unsafe.Sizeof(userStatsReceived_t{})-20]
+	_ = x[unsafe.Offsetof(userStatsReceived_t{}.m_eResult)-8]
+	_ = x[unsafe.Offsetof(userStatsReceived_t{}.m_steamIDUser)-12]
+
+	_ = x[unsafe.Sizeof(userStatsStored_t{})-12]
+	_ = x[unsafe.Offsetof(userStatsStored_t{}.m_eResult)-8]
+
+	_ = x[unsafe.Sizeof(userAchievementStored_t{})-148]
+	_ = x[unsafe.Offsetof(userAchievementStored_t{}.m_bGroupAchievement)-8]
+	_ = x[unsafe.Offsetof(userAchievementStored_t{}.m_rgchAchievementName)-9]
+	_ = x[unsafe.Offsetof(userAchievementStored_t{}.m_nCurProgress)-140]
+	_ = x[unsafe.Offsetof(userAchievementStored_t{}.m_nMaxProgress)-144]
+
+	_ = x[unsafe.Sizeof(avatarImageLoaded_t{})-20]
+	_ = x[unsafe.Offsetof(avatarImageLoaded_t{}.m_iImage)-8]
+
+	_ = x[unsafe.Sizeof(friendRichPresenceUpdate_t{})-12]
+	_ = x[unsafe.Offsetof(friendRichPresenceUpdate_t{}.m_nAppID)-8]
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+//go:build windows && (amd64 || arm64)
+
+package steamworks
+
+import (
+	"unsafe"
+)
+
+// callbackUint64 is a 64-bit integer in a callback struct.
+//
+// On Windows, callback structs are packed by 8 bytes (VALVE_CALLBACK_PACK_LARGE),
+// so a 64-bit integer is aligned to 8 bytes as Go's uint64.
+type callbackUint64 uint64
+
+func (c callbackUint64) get() uint64 {
+	return uint64(c)
+}
+
+// Assert the layouts of the callback structs packed by 8 bytes at compile time.
+func _() {
+	var x [1]struct{}
+
+	_ = x[unsafe.Sizeof(userStatsReceived_t{})-24]
+	_ = x[unsafe.Offsetof(userStatsReceived_t{}.m_eResult)-8]
+	_ = x[unsafe.Offsetof(userStatsReceived_t{}.m_steamIDUser)-16]
+
+	_ = x[unsafe.Sizeof(userStatsStored_t{})-16]
+	_ = x[unsafe.Offsetof(userStatsStored_t{}.m_eResult)-8]
+
+	_ = x[unsafe.Sizeof(userAchievementStored_t{})-152]
+	_ = x[unsafe.Offsetof(userAchievementStored_t{}.m_bGroupAchievement)-8]
+	_ = x[unsafe.Offsetof(userAchievementStored_t{}.m_rgchAchievementName)-9]
+	_ = x[unsafe.Offsetof(userAchievementStored_t{}.m_nCurProgress)-140]
+	_ = x[unsafe.Offsetof(userAchievementStored_t{}.m_nMaxProgress)-144]
+
+	_ = x[unsafe.Sizeof(avatarImageLoaded_t{})-24]
+	_ = x[unsafe.Offsetof(avatarImageLoaded_t{}.m_iImage)-8]
+
+	_ = x[unsafe.Sizeof(friendRichPresenceUpdate_t{})-16]
+	_ = x[unsafe.Offsetof(friendRichPresenceUpdate_t{}.m_nAppID)-8]
+}
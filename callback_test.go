@@ -0,0 +1,339 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+	"unsafe"
+)
+
+// packedBy8 reports whether the callback structs are packed by 8 bytes on this platform.
+func packedBy8() bool {
+	var c callbackUint64
+	return unsafe.Alignof(c) == 8
+}
+
+// pack returns v4 with the packing by 4 bytes, or v8 with the packing by 8 bytes.
+func pack(v4, v8 int) int {
+	if packedBy8() {
+		return v8
+	}
+	return v4
+}
+
+type fieldLayout struct {
+	name    string
+	offset4 uintptr
+	offset8 uintptr
+}
+
+func TestCallbackLayout(t *testing.T) {
+	testCases := []struct {
+		typ    reflect.Type
+		size4  uintptr
+		size8  uintptr
+		fields []fieldLayout
+	}{
+		{
+			typ:   reflect.TypeFor[gameOverlayActivated_t](),
+			size4: 12,
+			size8: 12,
+			fields: []fieldLayout{
+				{"m_bActive", 0, 0},
+				{"m_bUserInitiated", 1, 1},
+				{"m_nAppID", 4, 4},
+				{"m_dwOverlayPID", 8, 8},
+			},
+		},
+		{
+			typ:   reflect.TypeFor[avatarImageLoaded_t](),
+			size4: 20,
+			size8: 24,
+			fields: []fieldLayout{
+				{"m_steamID", 0, 0},
+				{"m_iImage", 8, 8},
+				{"m_iWide", 12, 12},
+				{"m_iTall", 16, 16},
+			},
+		},
+		{
+			typ:   reflect.TypeFor[friendRichPresenceUpdate_t](),
+			size4: 12,
+			size8: 16,
+			fields: []fieldLayout{
+				{"m_steamIDFriend", 0, 0},
+				{"m_nAppID", 8, 8},
+			},
+		},
+		{
+			typ:   reflect.TypeFor[dlcInstalled_t](),
+			size4: 4,
+			size8: 4,
+			fields: []fieldLayout{
+				{"m_nAppID", 0, 0},
+			},
+		},
+		{
+			typ:   reflect.TypeFor[userStatsReceived_t](),
+			size4: 20,
+			size8: 24,
+			fields: []fieldLayout{
+				{"m_nGameID", 0, 0},
+				{"m_eResult", 8, 8},
+				{"m_steamIDUser", 12, 16},
+			},
+		},
+		{
+			typ:   reflect.TypeFor[userStatsStored_t](),
+			size4: 12,
+			size8: 16,
+			fields: []fieldLayout{
+				{"m_nGameID", 0, 0},
+				{"m_eResult", 8, 8},
+			},
+		},
+		{
+			typ:   reflect.TypeFor[userAchievementStored_t](),
+			size4: 148,
+			size8: 152,
+			fields: []fieldLayout{
+				{"m_nGameID", 0, 0},
+				{"m_bGroupAchievement", 8, 8},
+				{"m_rgchAchievementName", 9, 9},
+				{"m_nCurProgress", 140, 140},
+				{"m_nMaxProgress", 144, 144},
+			},
+		},
+		{
+			typ:   reflect.TypeFor[steamAPICallCompleted_t](),
+			size4: 16,
+			size8: 16,
+			fields: []fieldLayout{
+				{"m_hAsyncCall", 0, 0},
+				{"m_iCallback", 8, 8},
+				{"m_cubParam", 12, 12},
+			},
+		},
+		{
+			typ:   reflect.TypeFor[remoteStorageFileWriteAsyncComplete_t](),
+			size4: 4,
+			size8: 4,
+			fields: []fieldLayout{
+				{"m_eResult", 0, 0},
+			},
+		},
+		{
+			typ:   reflect.TypeFor[friendGameInfo_t](),
+			size4: 24,
+			size8: 24,
+			fields: []fieldLayout{
+				{"m_gameID", 0, 0},
+				{"m_unGameIP", 8, 8},
+				{"m_usGamePort", 12, 12},
+				{"m_usQueryPort", 14, 14},
+				{"m_steamIDLobby", 16, 16},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.typ.Name(), func(t *testing.T) {
+			if got, want := tc.typ.Size(), uintptr(pack(int(tc.size4), int(tc.size8))); got != want {
+				t.Errorf("size: got: %d, want: %d", got, want)
+			}
+			if got, want := tc.typ.NumField(), len(tc.fields); got != want {
+				t.Fatalf("number of fields: got: %d, want: %d", got, want)
+			}
+			for i, f := range tc.fields {
+				sf := tc.typ.Field(i)
+				if sf.Name != f.name {
+					t.Errorf("field #%d: got: %s, want: %s", i, sf.Name, f.name)
+					continue
+				}
+				if got, want := sf.Offset, uintptr(pack(int(f.offset4), int(f.offset8))); got != want {
+					t.Errorf("offset of %s: got: %d, want: %d", f.name, got, want)
+				}
+			}
+		})
+	}
+}
+
+// decodeOne decodes data with the handlers registered for T, and returns the decoded values.
+func decodeOne[T any, PT callback[T]](t *testing.T, data []byte) []T {
+	t.Helper()
+
+	var got []T
+	unregister := OnCallback[T, PT](func(v T) {
+		got = append(got, v)
+	})
+	defer unregister()
+
+	for _, f := range decodeCallback(PT(nil).callbackID(), data) {
+		f()
+	}
+	return got
+}
+
+func TestDecodeUserStatsReceived(t *testing.T) {
+	data := make([]byte, pack(20, 24))
+	binary.LittleEndian.PutUint64(data[0:], 0x0123456789abcdef)
+	binary.LittleEndian.PutUint32(data[8:], uint32(EResult_OK))
+	binary.LittleEndian.PutUint64(data[pack(12, 16):], 76561197960287930)
+
+	got := decodeOne[UserStatsReceived](t, data)
+	want := UserStatsReceived{
+		GameID:      0x0123456789abcdef,
+		Result:      EResult_OK,
+		SteamIDUser: 76561197960287930,
+	}
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("got: %+v, want: [%+v]", got, want)
+	}
+
+	if got := decodeOne[UserStatsReceived](t, data[:len(data)-1]); len(got) != 0 {
+		t.Errorf("truncated: got: %+v, want: none", got)
+	}
+}
+
+func TestDecodeUserAchievementStored(t *testing.T) {
+	data := make([]byte, pack(148, 152))
+	binary.LittleEndian.PutUint64(data[0:], 480)
+	data[8] = 1
+	copy(data[9:], "ACH_WIN_ONE_GAME")
+	binary.LittleEndian.PutUint32(data[140:], 3)
+	binary.LittleEndian.PutUint32(data[144:], 10)
+
+	got := decodeOne[UserAchievementStored](t, data)
+	want := UserAchievementStored{
+		GameID:           480,
+		GroupAchievement: true,
+		AchievementName:  "ACH_WIN_ONE_GAME",
+		CurProgress:      3,
+		MaxProgress:      10,
+	}
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("got: %+v, want: [%+v]", got, want)
+	}
+
+	if got := decodeOne[UserAchievementStored](t, data[:147]); len(got) != 0 {
+		t.Errorf("truncated: got: %+v, want: none", got)
+	}
+}
+
+func TestDecodeAvatarImageLoaded(t *testing.T) {
+	data := make([]byte, pack(20, 24))
+	binary.LittleEndian.PutUint64(data[0:], 76561197960287930)
+	binary.LittleEndian.PutUint32(data[8:], 42)
+	binary.LittleEndian.PutUint32(data[12:], 184)
+	binary.LittleEndian.PutUint32(data[16:], 184)
+
+	got := decodeOne[AvatarImageLoaded](t, data)
+	want := AvatarImageLoaded{
+		SteamID: 76561197960287930,
+		Image:   42,
+		Width:   184,
+		Height:  184,
+	}
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("got: %+v, want: [%+v]", got, want)
+	}
+
+	if got := decodeOne[AvatarImageLoaded](t, data[:19]); len(got) != 0 {
+		t.Errorf("truncated: got: %+v, want: none", got)
+	}
+}
+
+func TestOnCallbackOrder(t *testing.T) {
+	var got []string
+	unregister1 := OnCallback(func(d DlcInstalled) {
+		got = append(got, fmt.Sprintf("1:%d", d.AppID))
+	})
+	defer unregister1()
+	unregister2 := OnCallback(func(d DlcInstalled) {
+		got = append(got, fmt.Sprintf("2:%d", d.AppID))
+	})
+	defer unregister2()
+
+	PostCallback(DlcInstalled{AppID: 480})
+	// The manual dispatch invokes the handlers in the same order.
+	for _, f := range decodeCallback((*DlcInstalled)(nil).callbackID(), binary.LittleEndian.AppendUint32(nil, 481)) {
+		f()
+	}
+
+	if want := []string{"1:480", "2:480", "1:481", "2:481"}; !slices.Equal(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func TestOnCallbackUnregisterInHandler(t *testing.T) {
+	var got []string
+	var unregister1 func()
+	unregister1 = OnCallback(func(DlcInstalled) {
+		got = append(got, "1")
+		unregister1()
+	})
+	unregister2 := OnCallback(func(DlcInstalled) {
+		got = append(got, "2")
+	})
+	defer unregister2()
+
+	// The handlers registered at the time of posting are all invoked, even if one is unregistered in the meantime.
+	PostCallback(DlcInstalled{})
+	PostCallback(DlcInstalled{})
+
+	if want := []string{"1", "2", "2"}; !slices.Equal(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+}
+
+func TestOnCallbackUnregisterTwice(t *testing.T) {
+	var got []string
+	unregister1 := OnCallback(func(DlcInstalled) {
+		got = append(got, "1")
+	})
+	unregister2 := OnCallback(func(DlcInstalled) {
+		got = append(got, "2")
+	})
+
+	unregister1()
+	// Unregistering again must not unregister another handler.
+	unregister1()
+	PostCallback(DlcInstalled{})
+	if want := []string{"2"}; !slices.Equal(got, want) {
+		t.Errorf("got: %v, want: %v", got, want)
+	}
+
+	unregister2()
+	callbackHandlersM.Lock()
+	_, ok := callbackHandlers[(*DlcInstalled)(nil).callbackID()]
+	callbackHandlersM.Unlock()
+	if ok {
+		t.Error("the handlers for the callback must be removed after all of them are unregistered")
+	}
+}
+
+func TestPostCallbackType(t *testing.T) {
+	var dlc, shutdown int
+	unregister1 := OnCallback(func(DlcInstalled) {
+		dlc++
+	})
+	defer unregister1()
+	unregister2 := OnCallback(func(SteamShutdown) {
+		shutdown++
+	})
+	defer unregister2()
+
+	PostCallback(DlcInstalled{})
+	if dlc != 1 || shutdown != 0 {
+		t.Errorf("DlcInstalled: got: %d, %d, want: 1, 0", dlc, shutdown)
+	}
+	PostCallback(SteamShutdown{})
+	if dlc != 1 || shutdown != 1 {
+		t.Errorf("SteamShutdown: got: %d, %d, want: 1, 1", dlc, shutdown)
+	}
+}
@@ -0,0 +1,169 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	// ErrIOFailure is returned by CallResult.Wait when the asynchronous call failed in Steam.
+	ErrIOFailure = errors.New("steamworks: API call I/O failure")
+
+	// ErrInvalidAPICall is returned by CallResult.Wait when the asynchronous call could not be started.
+	ErrInvalidAPICall = errors.New("steamworks: invalid API call")
+)
+
+// CallResult is the result of an asynchronous Steam API call.
+//
+// The result is delivered by RunCallbacks.
+type CallResult[T any] struct {
+	call SteamAPICall_t
+	done chan struct{}
+	once sync.Once
+
+	result T
+	err    error
+}
+
+type pendingCall struct {
+	callbackID int32
+	complete   func(data []byte, ioFailure bool)
+}
+
+var (
+	pendingCalls  = map[SteamAPICall_t]*pendingCall{}
+	pendingCallsM sync.Mutex
+)
+
+func newCallResult[T any, PT callback[T]](call SteamAPICall_t) *CallResult[T] {
+	c := &CallResult[T]{
+		call: call,
+		done: make(chan struct{}),
+	}
+	if call == k_uAPICallInvalid {
+		c.finish(*new(T), ErrInvalidAPICall)
+		return c
+	}
+
+	pendingCallsM.Lock()
+	defer pendingCallsM.Unlock()
+	pendingCalls[call] = &pendingCall{
+		callbackID: PT(nil).callbackID(),
+		complete: func(data []byte, ioFailure bool) {
+			var v T
+			if ioFailure {
+				c.finish(v, ErrIOFailure)
+				return
+			}
+			if !PT(&v).decode(data) {
+				c.finish(v, ErrIOFailure)
+				return
+			}
+			c.finish(v, nil)
+		},
+	}
+	return c
+}
+
+// CompletedCallResult returns a CallResult that is already completed with result and err.
+//
+// CompletedCallResult is useful to implement the interfaces without Steam, e.g., for testing.
+func CompletedCallResult[T any](result T, err error) *CallResult[T] {
+	c := &CallResult[T]{
+		done: make(chan struct{}),
+	}
+	c.finish(result, err)
+	return c
+}
+
+func (c *CallResult[T]) finish(result T, err error) {
+	c.once.Do(func() {
+		c.result = result
+		c.err = err
+		close(c.done)
+	})
+}
+
+// Wait blocks until the result is delivered by RunCallbacks or ctx is done.
+//
+// If ctx is done before the result is delivered, Wait returns ctx.Err(), and the result is discarded.
+// Subsequent calls to Wait return the same result and error.
+func (c *CallResult[T]) Wait(ctx context.Context) (T, error) {
+	select {
+	case <-c.done:
+		return c.result, c.err
+	case <-ctx.Done():
+	}
+
+	pendingCallsM.Lock()
+	delete(pendingCalls, c.call)
+	pendingCallsM.Unlock()
+
+	c.finish(*new(T), ctx.Err())
+	return c.result, c.err
+}
+
+// Done returns a channel that is closed when the result is available.
+func (c *CallResult[T]) Done() <-chan struct{} {
+	return c.done
+}
+
+// takePendingCall removes the pending call for the handle and returns it.
+// takePendingCall returns nil if no one waits for the result.
+func takePendingCall(call SteamAPICall_t) *pendingCall {
+	pendingCallsM.Lock()
+	defer pendingCallsM.Unlock()
+	p, ok := pendingCalls[call]
+	if !ok {
+		return nil
+	}
+	delete(pendingCalls, call)
+	return p
+}
+
+// abandonPendingCalls completes all the pending calls as failures.
+// abandonPendingCalls is called at Shutdown since the results will never be delivered.
+func abandonPendingCalls() {
+	pendingCallsM.Lock()
+	ps := pendingCalls
+	pendingCalls = map[SteamAPICall_t]*pendingCall{}
+	pendingCallsM.Unlock()
+
+	for _, p := range ps {
+		p.complete(nil, true)
+	}
+}
+
+type steamAPICallCompleted_t struct {
+	m_hAsyncCall SteamAPICall_t
+	m_iCallback  int32
+	m_cubParam   uint32
+}
+
+// RemoteStorageFileWriteAsyncComplete is the result of ISteamRemoteStorage.FileWriteAsync.
+type RemoteStorageFileWriteAsyncComplete struct {
+	Result EResult
+}
+
+type remoteStorageFileWriteAsyncComplete_t struct {
+	m_eResult EResult
+}
+
+func (*RemoteStorageFileWriteAsyncComplete) callbackID() int32 {
+	return k_iSteamRemoteStorageCallbacks + 31
+}
+
+func (r *RemoteStorageFileWriteAsyncComplete) decode(data []byte) bool {
+	var c remoteStorageFileWriteAsyncComplete_t
+	if !readStruct(data, &c) {
+		return false
+	}
+	*r = RemoteStorageFileWriteAsyncComplete{
+		Result: c.m_eResult,
+	}
+	return true
+}
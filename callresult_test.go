@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+)
+
+// completeCall delivers a result to the pending call for the handle, as the dispatch does.
+func completeCall(t *testing.T, call SteamAPICall_t, data []byte, ioFailure bool) {
+	t.Helper()
+	p := takePendingCall(call)
+	if p == nil {
+		t.Fatalf("no pending call for %d", call)
+	}
+	if got, want := p.callbackID, (*RemoteStorageFileWriteAsyncComplete)(nil).callbackID(); got != want {
+		t.Errorf("callbackID: got: %d, want: %d", got, want)
+	}
+	p.complete(data, ioFailure)
+}
+
+func TestCallResultComplete(t *testing.T) {
+	c := newCallResult[RemoteStorageFileWriteAsyncComplete](1)
+	select {
+	case <-c.Done():
+		t.Fatal("the result must not be available before the completion")
+	default:
+	}
+
+	completeCall(t, 1, binary.NativeEndian.AppendUint32(nil, uint32(EResult_OK)), false)
+	<-c.Done()
+	r, err := c.Wait(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Result != EResult_OK {
+		t.Errorf("got: %d, want: %d", r.Result, EResult_OK)
+	}
+	if p := takePendingCall(1); p != nil {
+		t.Error("the pending call must be removed after the completion")
+	}
+}
+
+func TestCallResultIOFailure(t *testing.T) {
+	testCases := []struct {
+		name      string
+		data      []byte
+		ioFailure bool
+	}{
+		{
+			name:      "I/O failure",
+			data:      binary.NativeEndian.AppendUint32(nil, uint32(EResult_OK)),
+			ioFailure: true,
+		},
+		{
+			name: "short data",
+			data: []byte{1},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newCallResult[RemoteStorageFileWriteAsyncComplete](2)
+			completeCall(t, 2, tc.data, tc.ioFailure)
+			if _, err := c.Wait(context.Background()); !errors.Is(err, ErrIOFailure) {
+				t.Errorf("got: %v, want: %v", err, ErrIOFailure)
+			}
+		})
+	}
+}
+
+func TestCallResultInvalid(t *testing.T) {
+	c := newCallResult[RemoteStorageFileWriteAsyncComplete](k_uAPICallInvalid)
+	if _, err := c.Wait(context.Background()); !errors.Is(err, ErrInvalidAPICall) {
+		t.Errorf("got: %v, want: %v", err, ErrInvalidAPICall)
+	}
+	if p := takePendingCall(k_uAPICallInvalid); p != nil {
+		t.Error("an invalid call must not be pending")
+	}
+}
+
+func TestCallResultWaitCanceled(t *testing.T) {
+	c := newCallResult[RemoteStorageFileWriteAsyncComplete](3)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got: %v, want: %v", err, context.DeadlineExceeded)
+	}
+	// The result is discarded after Wait returns.
+	if p := takePendingCall(3); p != nil {
+		t.Error("the pending call must be removed after Wait returns")
+	}
+	// Subsequent calls return the same error.
+	if _, err := c.Wait(context.Background()); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got: %v, want: %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestAbandonPendingCalls(t *testing.T) {
+	c1 := newCallResult[RemoteStorageFileWriteAsyncComplete](4)
+	c2 := newCallResult[RemoteStorageFileWriteAsyncComplete](5)
+	abandonPendingCalls()
+
+	for _, c := range []*CallResult[RemoteStorageFileWriteAsyncComplete]{c1, c2} {
+		if _, err := c.Wait(context.Background()); !errors.Is(err, ErrIOFailure) {
+			t.Errorf("got: %v, want: %v", err, ErrIOFailure)
+		}
+	}
+	if p := takePendingCall(4); p != nil {
+		t.Error("the pending calls must be removed")
+	}
+}
+
+func TestCompletedCallResult(t *testing.T) {
+	errTest := errors.New("test")
+	c := CompletedCallResult(RemoteStorageFileWriteAsyncComplete{Result: EResult_Fail}, errTest)
+	r, err := c.Wait(context.Background())
+	if !errors.Is(err, errTest) {
+		t.Errorf("got: %v, want: %v", err, errTest)
+	}
+	if r.Result != EResult_Fail {
+		t.Errorf("got: %d, want: %d", r.Result, EResult_Fail)
+	}
+}
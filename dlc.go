@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+// DLC is a DLC of the app.
+type DLC struct {
+	// AppID is the app ID of the DLC.
+	AppID AppId_t
+
+	// Name is the name of the DLC.
+	Name string
+
+	// Available reports whether the DLC is available in the store.
+	Available bool
+
+	// Installed reports whether the DLC is owned and installed.
+	Installed bool
+}
+
+// Beta is a beta branch of the app.
+type Beta struct {
+	// Name is the name of the branch, e.g., "public" for the default branch.
+	Name string
+
+	// Description is the description of the branch.
+	Description string
+
+	// BuildID is the build ID of the branch.
+	BuildID uint32
+
+	// Flags is the state of the branch, e.g., whether the branch is installed.
+	Flags EBetaBranchFlags
+}
+
+// DLCCatalog is a snapshot of the DLCs of the app.
+//
+// Content-gating code can take a DLCCatalog instead of ISteamApps, and be tested with a DLCCatalog literal.
+type DLCCatalog struct {
+	DLCs []DLC
+}
+
+// NewDLCCatalog returns a snapshot of the DLCs enumerated by apps.DLCs.
+func NewDLCCatalog(apps ISteamApps) *DLCCatalog {
+	c := &DLCCatalog{}
+	for dlc := range apps.DLCs() {
+		c.DLCs = append(c.DLCs, dlc)
+	}
+	return c
+}
+
+// Lookup returns the DLC whose app ID is appID.
+func (c *DLCCatalog) Lookup(appID AppId_t) (DLC, bool) {
+	for _, dlc := range c.DLCs {
+		if dlc.AppID == appID {
+			return dlc, true
+		}
+	}
+	return DLC{}, false
+}
+
+// IsInstalled reports whether the DLC whose app ID is appID is installed.
+func (c *DLCCatalog) IsInstalled(appID AppId_t) bool {
+	dlc, ok := c.Lookup(appID)
+	return ok && dlc.Installed
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/go-steamworks"
+	"github.com/hajimehoshi/go-steamworks/steamworkstest"
+)
+
+func TestDLCs(t *testing.T) {
+	s := steamworkstest.New()
+	longName := strings.Repeat("x", 200)
+	s.Apps.AddDLC(steamworks.DLC{AppID: 1001, Name: "Soundtrack", Available: true, Installed: true})
+	s.Apps.AddDLC(steamworks.DLC{AppID: 1002, Name: longName, Available: true})
+	s.Apps.AddDLC(steamworks.DLC{AppID: 1003, Name: "Expansion"})
+	s.Install(t)
+
+	var ids []steamworks.AppId_t
+	for dlc := range steamworks.SteamApps().DLCs() {
+		ids = append(ids, dlc.AppID)
+	}
+	if want := []steamworks.AppId_t{1001, 1002, 1003}; !slices.Equal(ids, want) {
+		t.Errorf("DLCs: got: %v, want: %v", ids, want)
+	}
+
+	// The iteration can stop early.
+	ids = ids[:0]
+	for dlc := range steamworks.SteamApps().DLCs() {
+		ids = append(ids, dlc.AppID)
+		break
+	}
+	if want := []steamworks.AppId_t{1001}; !slices.Equal(ids, want) {
+		t.Errorf("DLCs with break: got: %v, want: %v", ids, want)
+	}
+
+	c := steamworks.NewDLCCatalog(steamworks.SteamApps())
+	if dlc, ok := c.Lookup(1002); !ok || dlc.Name != longName {
+		t.Errorf("Lookup(1002): got: %+v, %t, want: the DLC with the long name", dlc, ok)
+	}
+	if _, ok := c.Lookup(9999); ok {
+		t.Error("Lookup(9999) must fail")
+	}
+	for _, tc := range []struct {
+		appID steamworks.AppId_t
+		want  bool
+	}{
+		{1001, true},
+		{1002, false},
+		{1003, false},
+		{9999, false},
+	} {
+		if got := c.IsInstalled(tc.appID); got != tc.want {
+			t.Errorf("IsInstalled(%d): got: %t, want: %t", tc.appID, got, tc.want)
+		}
+	}
+
+	// The catalog is a snapshot, and a new catalog reflects the installation.
+	steamworks.SteamApps().InstallDLC(1002)
+	s.Apps.FinishDLCInstall(1002)
+	if c.IsInstalled(1002) {
+		t.Error("IsInstalled(1002) must not change in the snapshot")
+	}
+	if !steamworks.NewDLCCatalog(steamworks.SteamApps()).IsInstalled(1002) {
+		t.Error("IsInstalled(1002) must be true after the installation")
+	}
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrSteamUnavailable is returned by Load, Init and InitEx when this package is built with the nosteam build tag.
+	ErrSteamUnavailable = errors.New("steamworks: Steam is unavailable in this build")
+
+	// ErrUnsupportedPlatform is returned by Load, Init and InitEx on a platform that the Steam API library doesn't support,
+	// e.g., 32-bit platforms.
+	ErrUnsupportedPlatform = errors.New("steamworks: the platform is not supported")
+
+	// ErrNotInitialized is returned by the methods of the no-op implementations that the accessors like SteamApps return
+	// when Steam is not initialized, e.g., before Init or with the nosteam build tag.
+	ErrNotInitialized = errors.New("steamworks: Steam is not initialized")
+
+	// ErrNoAvatar is returned by ISteamFriends.Avatar when the user has no avatar.
+	ErrNoAvatar = errors.New("steamworks: the user has no avatar")
+
+	// ErrInitFailedGeneric is the error for ESteamAPIInitResult_FailedGeneric.
+	ErrInitFailedGeneric = errors.New("steamworks: initialization failed")
+
+	// ErrNoSteamClient is the error for ESteamAPIInitResult_NoSteamClient.
+	// Typically, the Steam client is not running.
+	ErrNoSteamClient = errors.New("steamworks: cannot connect to the Steam client")
+
+	// ErrVersionMismatch is the error for ESteamAPIInitResult_VersionMismatch.
+	// Typically, the Steam client is older than the Steam API library.
+	ErrVersionMismatch = errors.New("steamworks: the Steam client version does not match")
+)
+
+// InitError is returned by Init when the Steam API initialization fails.
+//
+// InitError can be compared with ErrInitFailedGeneric, ErrNoSteamClient and ErrVersionMismatch by errors.Is.
+type InitError struct {
+	// Result is the result code of the initialization.
+	Result ESteamAPIInitResult
+
+	// Message is the error message reported by Steam.
+	Message string
+}
+
+func (e *InitError) Error() string {
+	return fmt.Sprintf("steamworks: initialization failed: %s: %s", e.Result, e.Message)
+}
+
+func (e *InitError) Unwrap() error {
+	switch e.Result {
+	case ESteamAPIInitResult_FailedGeneric:
+		return ErrInitFailedGeneric
+	case ESteamAPIInitResult_NoSteamClient:
+		return ErrNoSteamClient
+	case ESteamAPIInitResult_VersionMismatch:
+		return ErrVersionMismatch
+	}
+	return nil
+}
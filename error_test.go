@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+func TestInitError(t *testing.T) {
+	initErrs := []error{
+		steamworks.ErrInitFailedGeneric,
+		steamworks.ErrNoSteamClient,
+		steamworks.ErrVersionMismatch,
+	}
+
+	testCases := []struct {
+		result  steamworks.ESteamAPIInitResult
+		message string
+		want    error
+		str     string
+	}{
+		{
+			result:  steamworks.ESteamAPIInitResult_FailedGeneric,
+			message: "Failed to initialize",
+			want:    steamworks.ErrInitFailedGeneric,
+			str:     "steamworks: initialization failed: FailedGeneric: Failed to initialize",
+		},
+		{
+			result:  steamworks.ESteamAPIInitResult_NoSteamClient,
+			message: "Cannot create IPC pipe to Steam client process.  Steam is probably not running.",
+			want:    steamworks.ErrNoSteamClient,
+			str:     "steamworks: initialization failed: NoSteamClient: Cannot create IPC pipe to Steam client process.  Steam is probably not running.",
+		},
+		{
+			result:  steamworks.ESteamAPIInitResult_VersionMismatch,
+			message: "Steam client is out of date",
+			want:    steamworks.ErrVersionMismatch,
+			str:     "steamworks: initialization failed: VersionMismatch: Steam client is out of date",
+		},
+		{
+			result:  steamworks.ESteamAPIInitResult(100),
+			message: "unknown",
+			want:    nil,
+			str:     "steamworks: initialization failed: ESteamAPIInitResult(100): unknown",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.result.String(), func(t *testing.T) {
+			var err error = &steamworks.InitError{
+				Result:  tc.result,
+				Message: tc.message,
+			}
+			if got := err.Error(); got != tc.str {
+				t.Errorf("Error(): got: %q, want: %q", got, tc.str)
+			}
+
+			// The error matches only the sentinel error for its result.
+			for _, e := range initErrs {
+				if got, want := errors.Is(err, e), e == tc.want; got != want {
+					t.Errorf("errors.Is(err, %v): got: %t, want: %t", e, got, want)
+				}
+			}
+
+			var initErr *steamworks.InitError
+			if !errors.As(err, &initErr) || initErr.Result != tc.result || initErr.Message != tc.message {
+				t.Errorf("errors.As: got: %+v", initErr)
+			}
+		})
+	}
+}
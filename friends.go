@@ -0,0 +1,163 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"net/netip"
+	"unsafe"
+)
+
+// AvatarSize is the size of an avatar image.
+type AvatarSize int
+
+const (
+	// AvatarSizeSmall is 32x32 pixels.
+	AvatarSizeSmall AvatarSize = iota
+
+	// AvatarSizeMedium is 64x64 pixels.
+	AvatarSizeMedium
+
+	// AvatarSizeLarge is 184x184 pixels, or larger.
+	AvatarSizeLarge
+)
+
+// GameOverlayDialog is a dialog for ISteamFriends.ActivateGameOverlay.
+type GameOverlayDialog string
+
+const (
+	GameOverlayDialogFriends           GameOverlayDialog = "friends"
+	GameOverlayDialogCommunity         GameOverlayDialog = "community"
+	GameOverlayDialogPlayers           GameOverlayDialog = "players"
+	GameOverlayDialogSettings          GameOverlayDialog = "settings"
+	GameOverlayDialogOfficialGameGroup GameOverlayDialog = "officialgamegroup"
+	GameOverlayDialogStats             GameOverlayDialog = "stats"
+	GameOverlayDialogAchievements      GameOverlayDialog = "achievements"
+)
+
+// GameOverlayUserDialog is a dialog for ISteamFriends.ActivateGameOverlayToUser.
+type GameOverlayUserDialog string
+
+const (
+	// GameOverlayUserDialogSteamID opens the overlay web browser to the user's profile.
+	GameOverlayUserDialogSteamID GameOverlayUserDialog = "steamid"
+
+	// GameOverlayUserDialogChat opens a chat window to the user, or the group chat.
+	GameOverlayUserDialogChat GameOverlayUserDialog = "chat"
+
+	// GameOverlayUserDialogJoinTrade opens a window to a Steam Trading session that was started with the ISteamEconomy/StartTrade Web API.
+	GameOverlayUserDialogJoinTrade GameOverlayUserDialog = "jointrade"
+
+	// GameOverlayUserDialogStats opens the overlay web browser to the user's stats.
+	GameOverlayUserDialogStats GameOverlayUserDialog = "stats"
+
+	// GameOverlayUserDialogAchievements opens the overlay web browser to the user's achievements.
+	GameOverlayUserDialogAchievements GameOverlayUserDialog = "achievements"
+
+	// GameOverlayUserDialogFriendAdd opens the overlay in minimal mode prompting the current user to add the user as a friend.
+	GameOverlayUserDialogFriendAdd GameOverlayUserDialog = "friendadd"
+
+	// GameOverlayUserDialogFriendRemove opens the overlay in minimal mode prompting the current user to remove the user from their friends.
+	GameOverlayUserDialogFriendRemove GameOverlayUserDialog = "friendremove"
+
+	// GameOverlayUserDialogFriendRequestAccept opens the overlay in minimal mode prompting the current user to accept an incoming friend invite.
+	GameOverlayUserDialogFriendRequestAccept GameOverlayUserDialog = "friendrequestaccept"
+
+	// GameOverlayUserDialogFriendRequestIgnore opens the overlay in minimal mode prompting the current user to ignore an incoming friend invite.
+	GameOverlayUserDialogFriendRequestIgnore GameOverlayUserDialog = "friendrequestignore"
+)
+
+// Friend is a user in the friends list of the current user.
+type Friend struct {
+	// SteamID is the Steam ID of the user.
+	SteamID CSteamID
+
+	// PersonaName is the name of the user.
+	PersonaName string
+
+	// Nickname is the nickname that the current user set for the user. Nickname is empty if not set.
+	Nickname string
+
+	// PersonaState is the online state of the user.
+	PersonaState EPersonaState
+
+	// Relationship is the relationship between the current user and the user.
+	Relationship EFriendRelationship
+
+	// SteamLevel is the Steam level of the user. SteamLevel is 0 if the level is not known yet.
+	SteamLevel int32
+
+	// InGame reports whether the user is playing a game. Game is valid only when InGame is true.
+	InGame bool
+	Game   FriendGameInfo
+}
+
+// FriendGameInfo is the game that a friend is playing.
+type FriendGameInfo struct {
+	// GameID is the game ID, which includes AppID.
+	GameID uint64
+
+	// AppID is the app ID of the game.
+	AppID AppId_t
+
+	// IP is the IP address of the game server. IP is invalid if the friend is not on a game server.
+	IP netip.Addr
+
+	// GamePort and QueryPort are the ports of the game server.
+	GamePort  uint16
+	QueryPort uint16
+
+	// Lobby is the Steam ID of the lobby that the friend is in. Lobby is 0 if the friend is not in a lobby.
+	Lobby CSteamID
+}
+
+// GameAddrPort returns the address of the game server.
+// GameAddrPort returns an invalid address if the friend is not on a game server.
+func (f FriendGameInfo) GameAddrPort() netip.AddrPort {
+	if !f.IP.IsValid() {
+		return netip.AddrPort{}
+	}
+	return netip.AddrPortFrom(f.IP, f.GamePort)
+}
+
+// QueryAddrPort returns the address of the game server for the server queries.
+// QueryAddrPort returns an invalid address if the friend is not on a game server.
+func (f FriendGameInfo) QueryAddrPort() netip.AddrPort {
+	if !f.IP.IsValid() {
+		return netip.AddrPort{}
+	}
+	return netip.AddrPortFrom(f.IP, f.QueryPort)
+}
+
+type friendGameInfo_t struct {
+	m_gameID       uint64
+	m_unGameIP     uint32
+	m_usGamePort   uint16
+	m_usQueryPort  uint16
+	m_steamIDLobby CSteamID
+}
+
+func (f *friendGameInfo_t) toGo() FriendGameInfo {
+	info := FriendGameInfo{
+		GameID:    f.m_gameID,
+		AppID:     AppId_t(f.m_gameID & 0xffffff),
+		GamePort:  f.m_usGamePort,
+		QueryPort: f.m_usQueryPort,
+		Lobby:     f.m_steamIDLobby,
+	}
+	if ip := f.m_unGameIP; ip != 0 {
+		info.IP = netip.AddrFrom4([4]byte{byte(ip >> 24), byte(ip >> 16), byte(ip >> 8), byte(ip)})
+	}
+	return info
+}
+
+// Assert the layout of FriendGameInfo_t, which is the same with the packing by 4 bytes and by 8 bytes, at compile time.
+func _() {
+	var x [1]struct{}
+
+	_ = x[unsafe.Sizeof(friendGameInfo_t{})-24]
+	_ = x[unsafe.Offsetof(friendGameInfo_t{}.m_unGameIP)-8]
+	_ = x[unsafe.Offsetof(friendGameInfo_t{}.m_usGamePort)-12]
+	_ = x[unsafe.Offsetof(friendGameInfo_t{}.m_usQueryPort)-14]
+	_ = x[unsafe.Offsetof(friendGameInfo_t{}.m_steamIDLobby)-16]
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"encoding/binary"
+	"net/netip"
+	"testing"
+)
+
+func TestFriendGameInfo(t *testing.T) {
+	testCases := []struct {
+		name      string
+		ip        uint32
+		gamePort  uint16
+		queryPort uint16
+		game      netip.AddrPort
+		query     netip.AddrPort
+	}{
+		{
+			name:      "server",
+			ip:        0xc0a80102,
+			gamePort:  27015,
+			queryPort: 27016,
+			game:      netip.MustParseAddrPort("192.168.1.2:27015"),
+			query:     netip.MustParseAddrPort("192.168.1.2:27016"),
+		},
+		{
+			name:      "loopback",
+			ip:        0x7f000001,
+			gamePort:  1,
+			queryPort: 65535,
+			game:      netip.MustParseAddrPort("127.0.0.1:1"),
+			query:     netip.MustParseAddrPort("127.0.0.1:65535"),
+		},
+		{
+			name:     "not on a server",
+			ip:       0,
+			gamePort: 27015,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// The IP address is in the host byte order, while the struct is in the memory order of this platform.
+			data := make([]byte, 24)
+			binary.NativeEndian.PutUint64(data[0:], 0x0100000000000000|480)
+			binary.NativeEndian.PutUint32(data[8:], tc.ip)
+			binary.NativeEndian.PutUint16(data[12:], tc.gamePort)
+			binary.NativeEndian.PutUint16(data[14:], tc.queryPort)
+			binary.NativeEndian.PutUint64(data[16:], 109775241058543776)
+
+			var c friendGameInfo_t
+			if !readStruct(data, &c) {
+				t.Fatal("readStruct failed")
+			}
+			info := c.toGo()
+
+			if got, want := info.AppID, AppId_t(480); got != want {
+				t.Errorf("AppID: got: %d, want: %d", got, want)
+			}
+			if got, want := info.Lobby, CSteamID(109775241058543776); got != want {
+				t.Errorf("Lobby: got: %d, want: %d", got, want)
+			}
+			if got, want := info.IP, tc.game.Addr(); got != want {
+				t.Errorf("IP: got: %v, want: %v", got, want)
+			}
+			if got := info.GameAddrPort(); got != tc.game {
+				t.Errorf("GameAddrPort: got: %v, want: %v", got, tc.game)
+			}
+			if got := info.QueryAddrPort(); got != tc.query {
+				t.Errorf("QueryAddrPort: got: %v, want: %v", got, tc.query)
+			}
+		})
+	}
+}
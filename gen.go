@@ -3,24 +3,90 @@
 
 //go:build ignore
 
+// gen.go extracts the redistributable binaries from the Steamworks SDK,
+// and generates the bindings in zapi.go, zapi_native.go and zapi_nosteam.go from the SDK's steam_api.json.
+//
+// Declarations that already exist in the hand-written files of this package are not generated,
+// so hand-written bindings always take precedence.
+//
+// The generated functions missing in the loaded library, e.g., an older one, are not registered.
+// Their methods behave like the no-op implementations instead of making Load fail.
+//
+// With -json, gen.go generates the bindings from the given steam_api.json without the SDK zip.
+// TestGenerate uses this with testdata/gen/steam_api.json, and compares the result with testdata/gen/*.golden.
+// TestGeneratedFilesUpToDate compares the files generated from the SDK with the committed ones when the SDK zip exists.
+//
+// gen_overrides.json tunes the generation:
+//
+//	{
+//		// The interfaces to generate. If empty, all the interfaces are generated.
+//		"interfaces": ["ISteamApps", ...],
+//
+//		// The flat function names or the type names not to generate.
+//		"skip": ["SteamAPI_ISteamApps_GetDLCCount", ...],
+//
+//		// The Go function types of ptrAPI variables that replace the generated ones.
+//		// A function with an overridden signature is bound but has no generated method.
+//		"signatures": {"SteamAPI_ISteamUtils_GetImageRGBA": "func(uintptr, int32, []byte, int32) bool", ...}
+//	}
+
 package main
 
 import (
 	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"io"
 	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 const version = "161"
 
+const (
+	// generatedFile has the declarations that don't depend on the library, including the no-op implementations of the interfaces.
+	generatedFile = "zapi.go"
+
+	// generatedNativeFile has the declarations calling the library, built without the nosteam build tag on the supported platforms.
+	generatedNativeFile = "zapi_native.go"
+
+	// generatedNoSteamFile has the accessors of generatedNativeFile without the library, built with the nosteam build tag or on the unsupported platforms.
+	generatedNoSteamFile = "zapi_nosteam.go"
+
+	overridesFile = "gen_overrides.json"
+)
+
+// supportedPlatforms is the build constraint of the platforms where the Steam API library is loaded.
+const supportedPlatforms = "((linux && !android) || (darwin && !ios) || (freebsd && cgo) || windows) && (amd64 || arm64)"
+
+var (
+	flagWindows = flag.Bool("windows", false, "extract steam_api64.dll for the steamembed build tag")
+	flagJSON    = flag.String("json", "", "generate the bindings from the given steam_api.json without extracting the SDK")
+)
+
 func main() {
+	flag.Parse()
 	if err := run(); err != nil {
 		panic(err)
 	}
 }
 
 func run() error {
+	if *flagJSON != "" {
+		return processJSON(*flagJSON)
+	}
+
 	dir, err := os.MkdirTemp("", "go-steamworks")
 	if err != nil {
 		return err
@@ -54,16 +120,17 @@ func processZip(dir string) error {
 		return err
 	}
 
-	for path, filename := range map[string]string{
+	files := map[string]string{
 		"sdk/redistributable_bin/linux32/libsteam_api.so": "libsteam_api.so",
 		"sdk/redistributable_bin/linux64/libsteam_api.so": "libsteam_api64.so",
 		"sdk/redistributable_bin/osx/libsteam_api.dylib":  "libsteam_api.dylib",
-
-		// Do not include the Windows DLLs so far.
-		// Unlike macOS and Linux, this library is not self-contained for Windows and requries Windows DLLs for each game.
-		// "sdk/redistributable_bin/steam_api.dll":           "steam_api.dll",
-		// "sdk/redistributable_bin/win64/steam_api64.dll":   "steam_api64.dll",
-	} {
+	}
+	// The Windows DLL is extracted only when asked, as it is embedded only with the steamembed build tag.
+	// Without the tag, the DLL is copied next to each game, or passed to WithLibraryData.
+	if *flagWindows {
+		files["sdk/redistributable_bin/win64/steam_api64.dll"] = "steam_api64.dll"
+	}
+	for path, filename := range files {
 		f, err := r.Open(path)
 		if err != nil {
 			return err
@@ -81,5 +148,1069 @@ func processZip(dir string) error {
 		}
 	}
 
+	f, err := r.Open("sdk/public/steam/steam_api.json")
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var api steamAPI
+	if err := json.NewDecoder(f).Decode(&api); err != nil {
+		return fmt.Errorf("parsing steam_api.json failed: %w", err)
+	}
+
+	if err := generate(&api); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// processJSON generates the bindings from a steam_api.json file, e.g., testdata/gen/steam_api.json for testing.
+func processJSON(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var api steamAPI
+	if err := json.Unmarshal(b, &api); err != nil {
+		return fmt.Errorf("parsing %s failed: %w", path, err)
+	}
+
+	return generate(&api)
+}
+
+// steamAPI is the content of steam_api.json.
+type steamAPI struct {
+	CallbackStructs []apiStruct    `json:"callback_structs"`
+	Consts          []apiConst     `json:"consts"`
+	Enums           []apiEnum      `json:"enums"`
+	Interfaces      []apiInterface `json:"interfaces"`
+	Structs         []apiStruct    `json:"structs"`
+	Typedefs        []apiTypedef   `json:"typedefs"`
+}
+
+type apiStruct struct {
+	Struct     string     `json:"struct"`
+	CallbackID int        `json:"callback_id"`
+	Fields     []apiField `json:"fields"`
+	Enums      []apiEnum  `json:"enums"`
+}
+
+type apiField struct {
+	FieldName string `json:"fieldname"`
+	FieldType string `json:"fieldtype"`
+}
+
+type apiConst struct {
+	ConstName string `json:"constname"`
+	ConstType string `json:"consttype"`
+	ConstVal  string `json:"constval"`
+}
+
+type apiEnum struct {
+	EnumName string `json:"enumname"`
+	Values   []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"values"`
+}
+
+type apiInterface struct {
+	ClassName string `json:"classname"`
+	Accessors []struct {
+		Kind     string `json:"kind"`
+		Name     string `json:"name"`
+		NameFlat string `json:"name_flat"`
+	} `json:"accessors"`
+	Methods       []apiMethod `json:"methods"`
+	Enums         []apiEnum   `json:"enums"`
+	VersionString string      `json:"version_string"`
+}
+
+type apiMethod struct {
+	MethodName     string `json:"methodname"`
+	MethodNameFlat string `json:"methodname_flat"`
+	Params         []struct {
+		ParamName     string `json:"paramname"`
+		ParamType     string `json:"paramtype"`
+		ParamTypeFlat string `json:"paramtype_flat"`
+	} `json:"params"`
+	ReturnType     string `json:"returntype"`
+	ReturnTypeFlat string `json:"returntype_flat"`
+
+	// CallResult is the struct delivered as the result of an asynchronous method returning SteamAPICall_t.
+	CallResult string `json:"callresult"`
+}
+
+type apiTypedef struct {
+	Typedef string `json:"typedef"`
+	Type    string `json:"type"`
+}
+
+type overrides struct {
+	Interfaces []string          `json:"interfaces"`
+	Skip       []string          `json:"skip"`
+	Signatures map[string]string `json:"signatures"`
+}
+
+func loadOverrides() (*overrides, error) {
+	var o overrides
+	b, err := os.ReadFile(overridesFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &o, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &o); err != nil {
+		return nil, fmt.Errorf("parsing %s failed: %w", overridesFile, err)
+	}
+	return &o, nil
+}
+
+// declarations is the declarations in the hand-written Go files.
+type declarations struct {
+	// names is the top-level names.
+	names map[string]struct{}
+
+	// values maps a type name to the values of the typed integer constants like EFriendFlags_All.
+	values map[string]map[int64]struct{}
+
+	// interfaceVersions is the elements of the interfaceVersions variable.
+	interfaceVersions []string
+}
+
+// declaredNames returns the top-level declarations in the hand-written Go files in the current directory.
+func declaredNames() (*declarations, error) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		return nil, err
+	}
+
+	d := &declarations{
+		names:  map[string]struct{}{},
+		values: map[string]map[int64]struct{}{},
+	}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if file == "gen.go" || file == generatedFile || file == generatedNativeFile || file == generatedNoSteamFile || strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil {
+					continue
+				}
+				d.names[decl.Name.Name] = struct{}{}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						d.names[spec.Name.Name] = struct{}{}
+					case *ast.ValueSpec:
+						for _, n := range spec.Names {
+							d.names[n.Name] = struct{}{}
+						}
+						d.addValueSpec(decl.Tok, spec)
+					}
+				}
+			}
+		}
+	}
+	return d, nil
+}
+
+func (d *declarations) addValueSpec(tok token.Token, spec *ast.ValueSpec) {
+	if tok == token.VAR {
+		if len(spec.Names) != 1 || spec.Names[0].Name != "interfaceVersions" || len(spec.Values) != 1 {
+			return
+		}
+		lit, ok := spec.Values[0].(*ast.CompositeLit)
+		if !ok {
+			return
+		}
+		for _, e := range lit.Elts {
+			if b, ok := e.(*ast.BasicLit); ok && b.Kind == token.STRING {
+				if v, err := strconv.Unquote(b.Value); err == nil {
+					d.interfaceVersions = append(d.interfaceVersions, v)
+				}
+			}
+		}
+		return
+	}
+
+	typ, ok := spec.Type.(*ast.Ident)
+	if !ok {
+		return
+	}
+	for _, e := range spec.Values {
+		v, ok := intValue(e)
+		if !ok {
+			continue
+		}
+		if d.values[typ.Name] == nil {
+			d.values[typ.Name] = map[int64]struct{}{}
+		}
+		d.values[typ.Name][v] = struct{}{}
+	}
+}
+
+// intValue returns the value of an integer literal, which might be negated or parenthesized.
+func intValue(e ast.Expr) (int64, bool) {
+	switch e := e.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.INT {
+			return 0, false
+		}
+		v, err := strconv.ParseInt(e.Value, 0, 64)
+		if err != nil {
+			return 0, false
+		}
+		return v, true
+	case *ast.UnaryExpr:
+		if e.Op != token.SUB {
+			return 0, false
+		}
+		v, ok := intValue(e.X)
+		return -v, ok
+	case *ast.ParenExpr:
+		return intValue(e.X)
+	}
+	return 0, false
+}
+
+type generator struct {
+	api       *steamAPI
+	overrides *overrides
+	declared  *declarations
+
+	// types maps C type names to Go type names.
+	types map[string]goType
+
+	buf     bytes.Buffer
+	native  bytes.Buffer
+	nosteam bytes.Buffer
+}
+
+// goType is a Go type corresponding to a C type.
+type goType struct {
+	name  string
+	size  int
+	align int
+
+	// int64 reports whether the type is a 64-bit integer.
+	int64 bool
+}
+
+var primitiveTypes = map[string]goType{
+	"bool":               {"bool", 1, 1, false},
+	"char":               {"byte", 1, 1, false},
+	"signed char":        {"int8", 1, 1, false},
+	"unsigned char":      {"uint8", 1, 1, false},
+	"int8":               {"int8", 1, 1, false},
+	"uint8":              {"uint8", 1, 1, false},
+	"short":              {"int16", 2, 2, false},
+	"unsigned short":     {"uint16", 2, 2, false},
+	"int16":              {"int16", 2, 2, false},
+	"uint16":             {"uint16", 2, 2, false},
+	"int":                {"int32", 4, 4, false},
+	"signed int":         {"int32", 4, 4, false},
+	"unsigned int":       {"uint32", 4, 4, false},
+	"int32":              {"int32", 4, 4, false},
+	"uint32":             {"uint32", 4, 4, false},
+	"long long":          {"int64", 8, 8, true},
+	"unsigned long long": {"uint64", 8, 8, true},
+	"int64":              {"int64", 8, 8, true},
+	"uint64":             {"uint64", 8, 8, true},
+	"int64_t":            {"int64", 8, 8, true},
+	"uint64_t":           {"uint64", 8, 8, true},
+	"float":              {"float32", 4, 4, false},
+	"double":             {"float64", 8, 8, false},
+	"CSteamID":           {"CSteamID", 8, 8, true},
+	"uint64_steamid":     {"CSteamID", 8, 8, true},
+	"CGameID":            {"uint64", 8, 8, true},
+	"uint64_gameid":      {"uint64", 8, 8, true},
+}
+
+func generate(api *steamAPI) error {
+	o, err := loadOverrides()
+	if err != nil {
+		return err
+	}
+	declared, err := declaredNames()
+	if err != nil {
+		return err
+	}
+
+	g := &generator{
+		api:       api,
+		overrides: o,
+		declared:  declared,
+		types:     map[string]goType{},
+	}
+	for k, v := range primitiveTypes {
+		g.types[k] = v
+	}
+
+	files, err := g.generate()
+	if err != nil {
+		return err
+	}
+	for name, src := range files {
+		if err := os.WriteFile(name, src, 0644); err != nil {
+			return err
+		}
+	}
 	return nil
 }
+
+func (g *generator) isDeclared(name string) bool {
+	_, ok := g.declared.names[name]
+	return ok
+}
+
+func (g *generator) isSkipped(name string) bool {
+	return slices.Contains(g.overrides.Skip, name)
+}
+
+func (g *generator) p(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+	g.buf.WriteByte('\n')
+}
+
+// pn writes a line to generatedNativeFile.
+func (g *generator) pn(format string, args ...any) {
+	fmt.Fprintf(&g.native, format, args...)
+	g.native.WriteByte('\n')
+}
+
+// ps writes a line to generatedNoSteamFile.
+func (g *generator) ps(format string, args ...any) {
+	fmt.Fprintf(&g.nosteam, format, args...)
+	g.nosteam.WriteByte('\n')
+}
+
+func writeHeader(buf *bytes.Buffer, buildTag string) {
+	fmt.Fprintf(buf, "// SPDX-License-Identifier: Apache-2.0\n")
+	fmt.Fprintf(buf, "// SPDX-FileCopyrightText: 2026 The go-steamworks Authors\n")
+	fmt.Fprintf(buf, "\n")
+	fmt.Fprintf(buf, "// Code generated by gen.go from steam_api.json of Steamworks SDK %s. DO NOT EDIT.\n", version)
+	fmt.Fprintf(buf, "\n")
+	if buildTag != "" {
+		fmt.Fprintf(buf, "//go:build %s\n", buildTag)
+		fmt.Fprintf(buf, "\n")
+	}
+	fmt.Fprintf(buf, "package steamworks\n")
+	fmt.Fprintf(buf, "\n")
+}
+
+func (g *generator) generate() (map[string][]byte, error) {
+	writeHeader(&g.buf, "")
+
+	writeHeader(&g.native, "!nosteam && "+supportedPlatforms)
+	g.pn("func init() {")
+	g.pn("\tregisterGeneratedFunctions = registerZAPIFunctions")
+	g.pn("}")
+	g.pn("")
+
+	writeHeader(&g.nosteam, "nosteam || !("+supportedPlatforms+")")
+
+	g.genTypedefs()
+	g.genEnums()
+	g.genConsts()
+	g.genStructs()
+	g.genInterfaces()
+
+	files := map[string][]byte{}
+	for name, buf := range map[string]*bytes.Buffer{
+		generatedFile:        &g.buf,
+		generatedNativeFile:  &g.native,
+		generatedNoSteamFile: &g.nosteam,
+	} {
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("formatting %s failed: %w\n%s", name, err, buf.Bytes())
+		}
+		files[name] = src
+	}
+	return files, nil
+}
+
+func (g *generator) genTypedefs() {
+	// Resolve the typedefs until no more typedef is resolved, as a typedef can refer to another typedef.
+	for {
+		var resolved bool
+		for _, t := range g.api.Typedefs {
+			if _, ok := g.types[t.Typedef]; ok {
+				continue
+			}
+			base, ok := g.types[t.Type]
+			if !ok {
+				continue
+			}
+			g.types[t.Typedef] = goType{t.Typedef, base.size, base.align, base.int64}
+			resolved = true
+			if g.isDeclared(t.Typedef) || g.isSkipped(t.Typedef) {
+				continue
+			}
+			g.p("type %s %s", t.Typedef, base.name)
+		}
+		if !resolved {
+			break
+		}
+	}
+	g.p("")
+}
+
+func (g *generator) allEnums() []apiEnum {
+	enums := slices.Clone(g.api.Enums)
+	for _, i := range g.api.Interfaces {
+		enums = append(enums, i.Enums...)
+	}
+	for _, s := range g.api.CallbackStructs {
+		enums = append(enums, s.Enums...)
+	}
+	for _, s := range g.api.Structs {
+		enums = append(enums, s.Enums...)
+	}
+	return enums
+}
+
+// enumValuePrefix returns the prefix shared by all the values of an enum, e.g., "k_EPosition" for ENotificationPosition.
+// The prefix ends at a word boundary, and every value has at least one character after the prefix.
+// enumValuePrefix returns an empty string if the enum has less than two values or no such prefix exists.
+func enumValuePrefix(values []string) string {
+	if len(values) < 2 {
+		return ""
+	}
+
+	prefix := values[0]
+	for _, v := range values[1:] {
+		var n int
+		for n < len(prefix) && n < len(v) && prefix[n] == v[n] {
+			n++
+		}
+		prefix = prefix[:n]
+	}
+
+	isBoundary := func(prefix string) bool {
+		for _, v := range values {
+			if len(v) <= len(prefix) {
+				return false
+			}
+			if prefix[len(prefix)-1] == '_' {
+				continue
+			}
+			// A boundary is between a lower-case letter and an upper-case letter, e.g., "k_EPosition|TopLeft".
+			prev, next := prefix[len(prefix)-1], v[len(prefix)]
+			if !('a' <= prev && prev <= 'z') || !('A' <= next && next <= 'Z') {
+				return false
+			}
+		}
+		return true
+	}
+	for ; prefix != ""; prefix = prefix[:len(prefix)-1] {
+		if isBoundary(prefix) {
+			break
+		}
+	}
+
+	// A prefix without the enum part like "k_" is meaningless.
+	if strings.TrimPrefix(strings.TrimSuffix(prefix, "_"), "k") == "" {
+		return ""
+	}
+	return prefix
+}
+
+// enumValueName returns the Go name of an enum value in the same manner as ESteamInputType_Unknown.
+// prefix is the prefix shared by the values of the enum, which is replaced with the enum name.
+func enumValueName(enum, prefix, value string) string {
+	if prefix != "" {
+		return enum + "_" + strings.TrimPrefix(strings.TrimPrefix(value, prefix), "_")
+	}
+
+	v := strings.TrimPrefix(value, "k_")
+	if strings.HasPrefix(v, enum+"_") {
+		return v
+	}
+	if strings.HasPrefix(v, enum) {
+		return enum + "_" + strings.TrimPrefix(v, enum)
+	}
+	return enum + "_" + v
+}
+
+func (g *generator) genEnums() {
+	for _, e := range g.allEnums() {
+		if _, ok := g.types[e.EnumName]; ok {
+			continue
+		}
+		g.types[e.EnumName] = goType{name: e.EnumName, size: 4, align: 4}
+		if g.isSkipped(e.EnumName) {
+			continue
+		}
+		if !g.isDeclared(e.EnumName) {
+			g.p("type %s int32", e.EnumName)
+			g.p("")
+		}
+
+		var names []string
+		for _, v := range e.Values {
+			names = append(names, v.Name)
+		}
+		prefix := enumValuePrefix(names)
+
+		var lines []string
+		for _, v := range e.Values {
+			name := enumValueName(e.EnumName, prefix, v.Name)
+			if g.isDeclared(name) {
+				continue
+			}
+			n, err := strconv.ParseInt(v.Value, 0, 64)
+			if err != nil {
+				continue
+			}
+			// A value declared by hand with a different name is not generated so as not to duplicate the constant.
+			if _, ok := g.declared.values[e.EnumName][n]; ok {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("\t%s %s = %d", name, e.EnumName, n))
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		g.p("const (")
+		for _, l := range lines {
+			g.p("%s", l)
+		}
+		g.p(")")
+		g.p("")
+	}
+}
+
+func (g *generator) genConsts() {
+	var lines []string
+	for _, c := range g.api.Consts {
+		if g.isDeclared(c.ConstName) || g.isSkipped(c.ConstName) {
+			continue
+		}
+		val := strings.TrimSpace(c.ConstVal)
+		if strings.HasPrefix(val, "(") && strings.HasSuffix(val, ")") {
+			val = strings.TrimSpace(val[1 : len(val)-1])
+		}
+		var lit string
+		if n, err := strconv.ParseInt(val, 0, 64); err == nil {
+			lit = strconv.FormatInt(n, 10)
+		} else if n, err := strconv.ParseUint(val, 0, 64); err == nil {
+			lit = "0x" + strconv.FormatUint(n, 16)
+		} else {
+			continue
+		}
+		t, ok := g.types[c.ConstType]
+		if !ok {
+			lines = append(lines, fmt.Sprintf("\t%s = %s", c.ConstName, lit))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("\t%s %s = %s", c.ConstName, t.name, lit))
+	}
+	if len(lines) == 0 {
+		return
+	}
+	g.p("const (")
+	for _, l := range lines {
+		g.p("%s", l)
+	}
+	g.p(")")
+	g.p("")
+}
+
+var arrayTypeRe = regexp.MustCompile(`^(.+?)\s*\[(\d+)\]$`)
+
+// fieldType returns the Go type of a struct field.
+func (g *generator) fieldType(ctype string) (goType, bool) {
+	if m := arrayTypeRe.FindStringSubmatch(ctype); m != nil {
+		elem, ok := g.fieldType(m[1])
+		if !ok {
+			return goType{}, false
+		}
+		n, _ := strconv.Atoi(m[2])
+		return goType{name: fmt.Sprintf("[%d]%s", n, elem.name), size: elem.size * n, align: elem.align}, true
+	}
+	if t, ok := g.types[ctype]; ok {
+		return t, true
+	}
+	return goType{}, false
+}
+
+// layout returns the offsets of the fields and the size of a struct with the pack value.
+func layout(types []goType, pack int) (offsets []int, size int) {
+	maxAlign := 1
+	for _, t := range types {
+		align := min(t.align, pack)
+		maxAlign = max(maxAlign, align)
+		size = (size + align - 1) / align * align
+		offsets = append(offsets, size)
+		size += t.size
+	}
+	size = (size + maxAlign - 1) / maxAlign * maxAlign
+	return offsets, size
+}
+
+// structName returns the Go name of a C struct.
+// Structs are unexported since they mirror the C layout. Exported types are hand-written.
+func structName(name string) string {
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+func (g *generator) genStructs() {
+	var callbackIDs []string
+	structs := append(slices.Clone(g.api.Structs), g.api.CallbackStructs...)
+	for _, s := range structs {
+		if s.CallbackID != 0 {
+			name := "k_iCallback_" + s.Struct
+			if !g.isDeclared(name) {
+				callbackIDs = append(callbackIDs, fmt.Sprintf("\t%s = %d", name, s.CallbackID))
+			}
+		}
+
+		name := structName(s.Struct)
+		if g.isSkipped(s.Struct) {
+			continue
+		}
+
+		var types []goType
+		ok := true
+		for _, f := range s.Fields {
+			t, ok2 := g.fieldType(f.FieldType)
+			if !ok2 {
+				ok = false
+				break
+			}
+			types = append(types, t)
+		}
+		if !ok {
+			continue
+		}
+
+		// Callback structs are packed by 4 bytes on Linux and macOS and by 8 bytes on Windows.
+		offsets4, size4 := layout(types, 4)
+		offsets8, size8 := layout(types, 8)
+		if !slices.Equal(offsets4, offsets8) || size4 != size8 {
+			// The layouts differ by platform. 64-bit integers can be represented by callbackUint64,
+			// whose alignment depends on the platform. Other types aligned to 8 bytes cannot be represented.
+			if s.CallbackID == 0 || !g.replaceWithCallbackUint64(types) {
+				continue
+			}
+			// The struct is not registered as a type, as its size depends on the platform.
+			if g.isDeclared(name) {
+				continue
+			}
+			g.p("type %s struct {", name)
+			for i, f := range s.Fields {
+				if types[i].name == "callbackUint64" {
+					g.p("\t%s %s // %s", f.FieldName, types[i].name, f.FieldType)
+					continue
+				}
+				g.p("\t%s %s", f.FieldName, types[i].name)
+			}
+			g.p("}")
+			g.p("")
+			continue
+		}
+		maxAlign := 1
+		for _, t := range types {
+			maxAlign = max(maxAlign, t.align)
+		}
+		g.types[s.Struct] = goType{name: name, size: size8, align: maxAlign}
+
+		if g.isDeclared(name) {
+			continue
+		}
+		if len(s.Fields) == 0 {
+			g.p("type %s struct{}", name)
+			g.p("")
+			continue
+		}
+		g.p("type %s struct {", name)
+		for i, f := range s.Fields {
+			g.p("\t%s %s", f.FieldName, types[i].name)
+		}
+		g.p("}")
+		g.p("")
+	}
+
+	if len(callbackIDs) > 0 {
+		g.p("const (")
+		for _, l := range callbackIDs {
+			g.p("%s", l)
+		}
+		g.p(")")
+		g.p("")
+	}
+}
+
+// replaceWithCallbackUint64 replaces the 64-bit integer types with callbackUint64,
+// which is defined for each platform in callback_pack4.go and callback_pack8.go.
+// replaceWithCallbackUint64 returns false if a type aligned to 8 bytes other than 64-bit integers exists.
+func (g *generator) replaceWithCallbackUint64(types []goType) bool {
+	for i, t := range types {
+		if t.align < 8 {
+			continue
+		}
+		if !t.int64 {
+			return false
+		}
+		types[i] = goType{name: "callbackUint64", size: 8, align: 4}
+	}
+	return true
+}
+
+// param is a parameter of a flat function.
+type param struct {
+	name string
+	typ  string
+
+	// raw reports whether the parameter is a pointer and is passed as uintptr.
+	raw bool
+}
+
+var goKeywords = map[string]struct{}{
+	"break": {}, "case": {}, "chan": {}, "const": {}, "continue": {}, "default": {}, "defer": {}, "else": {},
+	"fallthrough": {}, "for": {}, "func": {}, "go": {}, "goto": {}, "if": {}, "import": {}, "interface": {},
+	"map": {}, "package": {}, "range": {}, "return": {}, "select": {}, "struct": {}, "switch": {}, "type": {}, "var": {},
+}
+
+// paramType returns the Go type of a parameter or a return value.
+// ok is false if the type cannot be passed with purego.
+func (g *generator) paramType(ctype, flat string) (typ string, raw bool, ok bool) {
+	if flat != "" {
+		ctype = flat
+	}
+	ctype = strings.TrimSpace(ctype)
+	if ctype == "const char *" {
+		return "string", false, true
+	}
+	if strings.HasSuffix(ctype, "*") || strings.HasSuffix(ctype, "&") {
+		return "uintptr", true, true
+	}
+	t, ok := g.types[ctype]
+	if !ok {
+		return "", false, false
+	}
+	if _, ok := primitiveTypes[ctype]; !ok && t.name == structName(ctype) {
+		// Structs by value are not supported.
+		return "", false, false
+	}
+	return t.name, false, true
+}
+
+// wrapperName returns the name of the Go type implementing the interface for an accessor.
+func wrapperName(accessor string) string {
+	return strings.ToLower(accessor[:1]) + accessor[1:]
+}
+
+// stubName returns the name of the Go type implementing the interface when Steam is not available for an accessor.
+func stubName(accessor string) string {
+	return "no" + accessor
+}
+
+func (g *generator) genInterfaces() {
+	type binding struct {
+		varName  string
+		flatName string
+		flatVal  string
+		sig      string
+	}
+	var bindings []binding
+	var versions []string
+
+	var wrappers bytes.Buffer
+	wp := func(format string, args ...any) {
+		fmt.Fprintf(&wrappers, format, args...)
+		wrappers.WriteByte('\n')
+	}
+
+	// stubs is the no-op implementations of the interfaces for generatedFile.
+	var stubs bytes.Buffer
+	sp := func(format string, args ...any) {
+		fmt.Fprintf(&stubs, format, args...)
+		stubs.WriteByte('\n')
+	}
+
+	ifaces := slices.Clone(g.api.Interfaces)
+	sort.SliceStable(ifaces, func(i, j int) bool {
+		return ifaces[i].ClassName < ifaces[j].ClassName
+	})
+	for _, iface := range ifaces {
+		if len(g.overrides.Interfaces) > 0 && !slices.Contains(g.overrides.Interfaces, iface.ClassName) {
+			continue
+		}
+		if g.isSkipped(iface.ClassName) {
+			continue
+		}
+
+		var accessor, accessorFlat string
+		for _, a := range iface.Accessors {
+			if a.Kind == "user" || a.Kind == "global" {
+				accessor = a.Name
+				accessorFlat = a.NameFlat
+				break
+			}
+		}
+		if accessor == "" {
+			continue
+		}
+
+		wrapper := wrapperName(accessor)
+		genWrapper := !g.isDeclared(iface.ClassName) && !g.isDeclared(wrapper) && !g.isDeclared(accessor)
+
+		if !g.isDeclared("ptrAPI_" + accessor) {
+			bindings = append(bindings, binding{
+				varName:  "ptrAPI_" + accessor,
+				flatName: "flatAPI_" + accessor,
+				flatVal:  accessorFlat,
+				sig:      "func() uintptr",
+			})
+		}
+
+		if genWrapper {
+			sp("type %s struct{}", stubName(accessor))
+			sp("")
+		}
+
+		var methods []string
+		for _, m := range iface.Methods {
+			if g.isSkipped(m.MethodNameFlat) {
+				continue
+			}
+			name := strings.TrimPrefix(m.MethodNameFlat, "SteamAPI_")
+			varName := "ptrAPI_" + name
+			if g.isDeclared(varName) {
+				continue
+			}
+
+			if sig, ok := g.overrides.Signatures[m.MethodNameFlat]; ok {
+				bindings = append(bindings, binding{
+					varName:  varName,
+					flatName: "flatAPI_" + name,
+					flatVal:  m.MethodNameFlat,
+					sig:      sig,
+				})
+				continue
+			}
+
+			var params []param
+			ok := true
+			for _, p := range m.Params {
+				t, raw, ok2 := g.paramType(p.ParamType, p.ParamTypeFlat)
+				if !ok2 {
+					ok = false
+					break
+				}
+				n := p.ParamName
+				if _, ok := goKeywords[n]; ok {
+					n += "_"
+				}
+				params = append(params, param{name: n, typ: t, raw: raw})
+			}
+			if !ok {
+				continue
+			}
+
+			var ret string
+			var retRaw bool
+			if m.ReturnType != "void" {
+				t, raw, ok := g.paramType(m.ReturnType, m.ReturnTypeFlat)
+				if !ok {
+					continue
+				}
+				ret = t
+				retRaw = raw
+			}
+
+			var cargs []string
+			cargs = append(cargs, "uintptr")
+			var hasRaw bool
+			for _, p := range params {
+				cargs = append(cargs, p.typ)
+				if p.raw {
+					hasRaw = true
+				}
+			}
+			sig := "func(" + strings.Join(cargs, ", ") + ")"
+			if ret != "" {
+				sig += " " + ret
+			}
+			bindings = append(bindings, binding{
+				varName:  varName,
+				flatName: "flatAPI_" + name,
+				flatVal:  m.MethodNameFlat,
+				sig:      sig,
+			})
+
+			// Methods with pointers are bound but not wrapped, as they need hand-written wrappers.
+			if !genWrapper || hasRaw || retRaw {
+				continue
+			}
+
+			// An asynchronous method returns a CallResult of the hand-written exported type of the result struct.
+			// Without the exported type, the method is bound but not wrapped.
+			var result string
+			if ret == "SteamAPICall_t" {
+				result = strings.TrimSuffix(m.CallResult, "_t")
+				if result == "" || !g.isDeclared(result) {
+					continue
+				}
+			}
+
+			var ps, args []string
+			args = append(args, "uintptr(s)")
+			for _, p := range params {
+				ps = append(ps, p.name+" "+p.typ)
+				args = append(args, p.name)
+			}
+			decl := m.MethodName + "(" + strings.Join(ps, ", ") + ")"
+			switch {
+			case result != "":
+				decl += " *CallResult[" + result + "]"
+			case ret != "":
+				decl += " " + ret
+			}
+			methods = append(methods, decl)
+
+			sp("func (%s) %s {", stubName(accessor), decl)
+			switch {
+			case result != "":
+				sp("\treturn CompletedCallResult(%s{}, ErrNotInitialized)", result)
+			case ret == "bool":
+				sp("\treturn false")
+			case ret == "string":
+				sp("\treturn \"\"")
+			case ret != "":
+				sp("\treturn 0")
+			}
+			sp("}")
+			sp("")
+
+			// A function missing in an older library is not registered. The method behaves like the no-op implementation then.
+			var names []string
+			for _, p := range params {
+				names = append(names, p.name)
+			}
+			wp("func (s %s) %s {", wrapper, decl)
+			wp("\tif %s == nil {", varName)
+			if ret != "" {
+				wp("\t\treturn %s{}.%s(%s)", stubName(accessor), m.MethodName, strings.Join(names, ", "))
+			} else {
+				wp("\t\t%s{}.%s(%s)", stubName(accessor), m.MethodName, strings.Join(names, ", "))
+				wp("\t\treturn")
+			}
+			wp("\t}")
+			switch {
+			case result != "":
+				wp("\treturn callAsync[%s](func() %s {", result, ret)
+				wp("\t\treturn %s(%s)", varName, strings.Join(args, ", "))
+				wp("\t})")
+			case ret != "":
+				wp("\treturn serialize(func() %s {", ret)
+				wp("\t\treturn %s(%s)", varName, strings.Join(args, ", "))
+				wp("\t})")
+			default:
+				wp("\tserializeDo(func() {")
+				wp("\t\t%s(%s)", varName, strings.Join(args, ", "))
+				wp("\t})")
+			}
+			wp("}")
+			wp("")
+		}
+
+		if !genWrapper {
+			continue
+		}
+
+		if iface.VersionString != "" && !slices.Contains(g.declared.interfaceVersions, iface.VersionString) {
+			versions = append(versions, iface.VersionString)
+		}
+
+		g.p("type %s interface {", iface.ClassName)
+		for _, m := range methods {
+			g.p("\t%s", m)
+		}
+		g.p("}")
+		g.p("")
+
+		// Backend doesn't have the generated accessors. A backend provides them by implementing the same methods.
+		// Like the hand-written accessors, the accessors return the no-op implementations when Steam is not available.
+		g.pn("func %s() %s {", accessor, iface.ClassName)
+		g.pn("\tif b := currentBackend(); b != nil {")
+		g.pn("\t\tif b, ok := b.(interface{ %s() %s }); ok {", accessor, iface.ClassName)
+		g.pn("\t\t\treturn b.%s()", accessor)
+		g.pn("\t\t}")
+		g.pn("\t\treturn %s{}", stubName(accessor))
+		g.pn("\t}")
+		g.pn("\tif CurrentState() != StateInitialized || ptrAPI_%s == nil {", accessor)
+		g.pn("\t\treturn %s{}", stubName(accessor))
+		g.pn("\t}")
+		g.pn("\tv := serialize(func() uintptr {")
+		g.pn("\t\treturn ptrAPI_%s()", accessor)
+		g.pn("\t})")
+		g.pn("\tif v == 0 {")
+		g.pn("\t\treturn %s{}", stubName(accessor))
+		g.pn("\t}")
+		g.pn("\treturn %s(v)", wrapper)
+		g.pn("}")
+		g.pn("")
+		g.pn("type %s uintptr", wrapper)
+		g.pn("")
+
+		g.ps("func %s() %s {", accessor, iface.ClassName)
+		g.ps("\tif b, ok := currentBackend().(interface{ %s() %s }); ok {", accessor, iface.ClassName)
+		g.ps("\t\treturn b.%s()", accessor)
+		g.ps("\t}")
+		g.ps("\treturn %s{}", stubName(accessor))
+		g.ps("}")
+		g.ps("")
+	}
+
+	g.native.Write(wrappers.Bytes())
+	// The no-op implementations are used in all the builds.
+	g.buf.Write(stubs.Bytes())
+
+	// The versions of the generated interfaces are checked by InitEx as well as the hand-written ones.
+	if len(versions) > 0 {
+		g.p("func init() {")
+		g.p("\tinterfaceVersions = append(interfaceVersions,")
+		for _, v := range versions {
+			g.p("\t\t%q,", v)
+		}
+		g.p("\t)")
+		g.p("}")
+		g.p("")
+	}
+
+	g.p("const (")
+	for _, b := range bindings {
+		g.p("\t%s = %q", b.flatName, b.flatVal)
+	}
+	g.p(")")
+	g.p("")
+
+	g.pn("var (")
+	for _, b := range bindings {
+		g.pn("\t%s %s", b.varName, b.sig)
+	}
+	g.pn(")")
+	g.pn("")
+
+	g.pn("func registerZAPIFunctions(lib uintptr) {")
+	for _, b := range bindings {
+		g.pn("\tregisterLibFuncIfExists(&%s, lib, %s)", b.varName, b.flatName)
+	}
+	g.pn("}")
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks_test
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var flagUpdate = flag.Bool("update", false, "update the golden files in testdata/gen")
+
+// generatedFiles are the files generated by gen.go.
+var generatedFiles = []string{"zapi.go", "zapi_native.go", "zapi_nosteam.go"}
+
+// runGen runs gen.go with args in a copy of this package, and returns the directory of the copy.
+// gen.go reads the hand-written files in the current directory, so gen.go must not run in this package directly.
+func runGen(t *testing.T, args ...string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	entries, err := os.ReadDir(".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		if !e.Type().IsRegular() || strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		if err := copyFile(filepath.Join(dir, e.Name()), e.Name()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	runGo(t, dir, append([]string{"run", "gen.go"}, args...)...)
+	return dir
+}
+
+func runGo(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go %s failed: %v\n%s", strings.Join(args, " "), err, out)
+	}
+}
+
+// TestGenerate runs gen.go with testdata/gen/steam_api.json in a copy of this package,
+// and compares the generated files with the golden files in testdata/gen.
+func TestGenerate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	fixture, err := filepath.Abs(filepath.Join("testdata", "gen", "steam_api.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := runGen(t, "-json", fixture)
+
+	for _, name := range generatedFiles {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		golden := filepath.Join("testdata", "gen", name+".golden")
+		if *flagUpdate {
+			if err := os.WriteFile(golden, got, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s doesn't match %s; run the test with -update if the change is expected\n%s", name, golden, got)
+		}
+	}
+
+	// The generated files must compile with the hand-written files.
+	runGo(t, dir, "vet", ".")
+	runGo(t, dir, "vet", "-tags", "nosteam", ".")
+}
+
+// TestGeneratedFilesUpToDate regenerates the files from the Steamworks SDK, and compares them with the files in this package.
+// The test is skipped unless the SDK is put in this package's directory, as the SDK is not redistributable.
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+	if _, err := os.Stat("steamworks_sdk_161.zip"); err != nil {
+		t.Skip("steamworks_sdk_161.zip is not found")
+	}
+
+	dir := runGen(t)
+	for _, name := range generatedFiles {
+		got, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := os.ReadFile(name)
+		if err != nil {
+			t.Errorf("%s is not committed; run go generate: %v", name, err)
+			continue
+		}
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s is not up to date; run go generate", name)
+		}
+	}
+}
+
+func copyFile(dst, src string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
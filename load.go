@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"strings"
+)
+
+// LoadOption is an option for Load.
+//
+// Each option adds a source of the library. Load tries the sources in the order of the options,
+// and uses the first library that is loaded successfully.
+type LoadOption func(*loadOptions)
+
+type loadOptions struct {
+	sources []librarySource
+}
+
+type librarySourceKind int
+
+const (
+	librarySourcePath librarySourceKind = iota
+	librarySourceNextToExecutable
+	librarySourceSystem
+	librarySourceEmbedded
+	librarySourceEnv
+	librarySourceData
+)
+
+// librarySource is a source of the library.
+type librarySource struct {
+	kind librarySourceKind
+
+	// value is the path for librarySourcePath, or the environment variable name for librarySourceEnv.
+	value string
+
+	// data is the content of the library for librarySourceData.
+	data []byte
+}
+
+// WithLibraryPath adds the library file at path as a source.
+//
+// On Windows, a relative path is resolved against the executable's directory, not the current directory.
+// A file name without a directory is searched like WithSystemLibrary.
+func WithLibraryPath(path string) LoadOption {
+	return func(o *loadOptions) {
+		o.sources = append(o.sources, librarySource{kind: librarySourcePath, value: path})
+	}
+}
+
+// WithSearchNextToExecutable adds the library file in the directory of the executable as a source.
+func WithSearchNextToExecutable() LoadOption {
+	return func(o *loadOptions) {
+		o.sources = append(o.sources, librarySource{kind: librarySourceNextToExecutable})
+	}
+}
+
+// WithSystemLibrary adds the library file found by the system's search order as a source,
+// e.g., LD_LIBRARY_PATH on Linux.
+// On Windows, the library is searched only in the executable's directory and System32, not in the current directory or PATH.
+func WithSystemLibrary() LoadOption {
+	return func(o *loadOptions) {
+		o.sources = append(o.sources, librarySource{kind: librarySourceSystem})
+	}
+}
+
+// WithEmbedded adds the library embedded in this package as a source.
+func WithEmbedded() LoadOption {
+	return func(o *loadOptions) {
+		o.sources = append(o.sources, librarySource{kind: librarySourceEmbedded})
+	}
+}
+
+// WithLibraryData adds the library whose content is data as a source.
+// The library is extracted to the user cache directory like the embedded library.
+//
+// WithLibraryData is useful to embed a library that this package doesn't embed, e.g., steam_api64.dll:
+//
+//	//go:embed steam_api64.dll
+//	var steamAPIDLL []byte
+//
+//	err := steamworks.Load(steamworks.WithLibraryData(steamAPIDLL))
+func WithLibraryData(data []byte) LoadOption {
+	return func(o *loadOptions) {
+		o.sources = append(o.sources, librarySource{kind: librarySourceData, data: data})
+	}
+}
+
+// WithEnvOverride adds the library file at the path specified by the environment variable name as a source.
+// The source is skipped if the environment variable is not set.
+func WithEnvOverride(name string) LoadOption {
+	return func(o *loadOptions) {
+		o.sources = append(o.sources, librarySource{kind: librarySourceEnv, value: name})
+	}
+}
+
+// LoadAttempt is an attempt to load the library.
+type LoadAttempt struct {
+	// Path is the path of the library file, or a description of the source.
+	Path string
+
+	// Err is the error of the attempt.
+	Err error
+}
+
+// LoadError is returned by Load when the library cannot be loaded from any source.
+type LoadError struct {
+	// Attempts is the list of the attempts in the tried order.
+	Attempts []LoadAttempt
+}
+
+func (e *LoadError) Error() string {
+	var b strings.Builder
+	b.WriteString("steamworks: loading the library failed")
+	for _, a := range e.Attempts {
+		b.WriteString("\n\t")
+		b.WriteString(a.Path)
+		b.WriteString(": ")
+		b.WriteString(a.Err.Error())
+	}
+	return b.String()
+}
+
+func (e *LoadError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Attempts))
+	for _, a := range e.Attempts {
+		errs = append(errs, a.Err)
+	}
+	return errs
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+//go:build !nosteam && ((linux && !android) || (darwin && !ios) || (freebsd && cgo) || windows) && (amd64 || arm64)
+
+package steamworks
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// testLoadError is the error of a stubbed attempt to load the library.
+type testLoadError struct {
+	path string
+}
+
+func (e *testLoadError) Error() string {
+	return "test error for " + e.path
+}
+
+// stubLoadLibPath replaces loadLibPath during the test.
+// The stub succeeds only for the path ok, and records the tried paths.
+func stubLoadLibPath(t *testing.T, ok string) *[]string {
+	var tried []string
+	orig := loadLibPath
+	loadLibPath = func(path string) (uintptr, error) {
+		tried = append(tried, path)
+		if path == ok {
+			return 1, nil
+		}
+		return 0, &testLoadError{path: path}
+	}
+	t.Cleanup(func() {
+		loadLibPath = orig
+	})
+
+	// No library is embedded during the test.
+	origEmbedded := libSteamAPI
+	libSteamAPI = nil
+	t.Cleanup(func() {
+		libSteamAPI = origEmbedded
+	})
+
+	return &tried
+}
+
+func loadOptionsOf(opts ...LoadOption) *loadOptions {
+	var o loadOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &o
+}
+
+func TestLoadLibOrder(t *testing.T) {
+	tried := stubLoadLibPath(t, "")
+	t.Setenv("STEAMWORKS_TEST_LIB", "env.lib")
+
+	_, err := loadLib(loadOptionsOf(
+		WithEnvOverride("STEAMWORKS_TEST_LIB"),
+		WithLibraryPath("path.lib"),
+		WithEmbedded(),
+		WithSystemLibrary(),
+	))
+
+	var loadErr *LoadError
+	if !errors.As(err, &loadErr) {
+		t.Fatalf("got: %v, want: a *LoadError", err)
+	}
+	var paths []string
+	for _, a := range loadErr.Attempts {
+		paths = append(paths, a.Path)
+	}
+	if want := []string{"env.lib", "path.lib", "(embedded)", libFileName}; !slices.Equal(paths, want) {
+		t.Errorf("attempts: got: %v, want: %v", paths, want)
+	}
+	// The embedded source doesn't load a file as nothing is embedded.
+	if want := []string{"env.lib", "path.lib", libFileName}; !slices.Equal(*tried, want) {
+		t.Errorf("tried: got: %v, want: %v", *tried, want)
+	}
+}
+
+func TestLoadLibFirstSuccess(t *testing.T) {
+	tried := stubLoadLibPath(t, "path.lib")
+
+	lib, err := loadLib(loadOptionsOf(
+		WithEnvOverride("STEAMWORKS_TEST_LIB_UNSET"),
+		WithLibraryPath("path.lib"),
+		WithSystemLibrary(),
+	))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lib != 1 {
+		t.Errorf("got: %d, want: 1", lib)
+	}
+	// An unset environment variable is skipped, and the sources after the success are not tried.
+	if want := []string{"path.lib"}; !slices.Equal(*tried, want) {
+		t.Errorf("tried: got: %v, want: %v", *tried, want)
+	}
+}
+
+func TestLoadError(t *testing.T) {
+	stubLoadLibPath(t, "")
+
+	_, err := loadLib(loadOptionsOf(
+		WithEnvOverride("STEAMWORKS_TEST_LIB_UNSET"),
+		WithLibraryPath("a.lib"),
+		WithLibraryPath("b.lib"),
+	))
+	if err == nil {
+		t.Fatal("loadLib must fail")
+	}
+
+	msg := err.Error()
+	for _, s := range []string{
+		"$STEAMWORKS_TEST_LIB_UNSET: environment variable STEAMWORKS_TEST_LIB_UNSET is not set",
+		"a.lib: test error for a.lib",
+		"b.lib: test error for b.lib",
+	} {
+		if !strings.Contains(msg, s) {
+			t.Errorf("the error must contain %q: %s", s, msg)
+		}
+	}
+
+	// The error unwraps to the cause of each attempt.
+	var loadErr *LoadError
+	if !errors.As(err, &loadErr) || len(loadErr.Attempts) != 3 {
+		t.Fatalf("got: %v, want: a *LoadError with 3 attempts", err)
+	}
+	for _, a := range loadErr.Attempts {
+		if !errors.Is(err, a.Err) {
+			t.Errorf("errors.Is(err, %v) must be true", a.Err)
+		}
+	}
+	var testErr *testLoadError
+	if !errors.As(err, &testErr) || testErr.path != "a.lib" {
+		t.Errorf("errors.As: got: %v, want: the error for a.lib", testErr)
+	}
+}
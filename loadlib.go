@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+//go:build !nosteam && ((linux && !android) || (darwin && !ios) || (freebsd && cgo) || windows) && (amd64 || arm64)
+
+package steamworks
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+)
+
+// loadLibPath loads the library file at path.
+// loadLibPath is a variable so that tests can replace it.
+var loadLibPath = openLib
+
+// load loads the library from the source.
+// The returned path is used for error messages, and is set even when loading fails.
+func (s librarySource) load() (lib uintptr, path string, err error) {
+	switch s.kind {
+	case librarySourcePath:
+		lib, err := loadLibPath(s.value)
+		return lib, s.value, err
+	case librarySourceNextToExecutable:
+		exe, err := os.Executable()
+		if err != nil {
+			return 0, "(next to the executable)", err
+		}
+		path := filepath.Join(filepath.Dir(exe), libFileName)
+		lib, err := loadLibPath(path)
+		return lib, path, err
+	case librarySourceSystem:
+		lib, err := loadLibPath(libFileName)
+		return lib, libFileName, err
+	case librarySourceEmbedded:
+		if libSteamAPI == nil {
+			return 0, "(embedded)", fmt.Errorf("no library is embedded for %s/%s", runtime.GOOS, runtime.GOARCH)
+		}
+		return loadLibData(libSteamAPI, "(embedded)")
+	case librarySourceData:
+		return loadLibData(s.data, "(data)")
+	case librarySourceEnv:
+		path := os.Getenv(s.value)
+		if path == "" {
+			return 0, "$" + s.value, fmt.Errorf("environment variable %s is not set", s.value)
+		}
+		lib, err := loadLibPath(path)
+		return lib, path, err
+	}
+	panic(fmt.Sprintf("steamworks: unexpected library source: %d", s.kind))
+}
+
+func loadLib(opts *loadOptions) (uintptr, error) {
+	sources := opts.sources
+	if len(sources) == 0 {
+		var o loadOptions
+		for _, opt := range defaultLoadOptions() {
+			opt(&o)
+		}
+		sources = o.sources
+	}
+
+	var loadErr LoadError
+	for _, s := range sources {
+		lib, path, err := s.load()
+		if err == nil {
+			return lib, nil
+		}
+		loadErr.Attempts = append(loadErr.Attempts, LoadAttempt{
+			Path: path,
+			Err:  err,
+		})
+	}
+	return 0, &loadErr
+}
+
+// loadLibData extracts the library whose content is data, and loads it.
+// desc describes the source for error messages when the library is not extracted.
+//
+// The extracted file is shared by the processes using the same library, so it is not removed on Shutdown.
+// Instead, extractLib removes the files of the other libraries that have not been used for a while.
+func loadLibData(data []byte, desc string) (lib uintptr, path string, err error) {
+	path, err = extractLib(data)
+	if err != nil {
+		return 0, desc, err
+	}
+	lib, err = openExtractedLib(path, sha256.Sum256(data))
+	return lib, path, err
+}
+
+// openExtractedLib opens the extracted library at path after verifying that its content hash is hash.
+//
+// The file in the cache directory can be replaced by anyone who can write to the directory.
+// The verified file is loaded through the file opened by openLibFile, so that the file cannot be replaced after the verification.
+func openExtractedLib(path string, hash [sha256.Size]byte) (uintptr, error) {
+	f, err := openLibFile(path)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return 0, err
+	}
+	if !bytes.Equal(h.Sum(nil), hash[:]) {
+		return 0, fmt.Errorf("the content of %s does not match the library", path)
+	}
+	return openVerifiedLib(f, path)
+}
+
+// staleLibDuration is the duration after which an extracted library that has not been used is removed.
+const staleLibDuration = 30 * 24 * time.Hour
+
+// extractLib writes the library data to the cache directory and returns its path.
+// The path depends on the content of the library, so the same file is reused across processes.
+// An existing file is rewritten if its content differs from data.
+//
+// extractLib fails if the user cache directory is not available,
+// as a shared directory like the temporary directory might let another user replace the library.
+//
+// Each library is extracted to its own directory, whose modification time is updated whenever the library is used.
+// extractLib removes the directories of the other libraries that have not been used for staleLibDuration,
+// e.g., the libraries of older SDK versions.
+func extractLib(data []byte) (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("the user cache directory to extract the library is not available: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	root := filepath.Join(cacheDir, "go-steamworks")
+	dir := filepath.Join(root, hex.EncodeToString(hash[:16]))
+	defer pruneExtractedLibs(root, dir)
+
+	path := filepath.Join(dir, libFileName)
+	// Reuse the existing file only when its content is the same.
+	// A file with the same size might be corrupted or tampered with.
+	if existing, err := os.ReadFile(path); err == nil && sha256.Sum256(existing) == hash {
+		now := time.Now()
+		_ = os.Chtimes(dir, now, now)
+		return path, nil
+	}
+
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	// Write to a temporary file and rename it so that other processes never see a partially written file.
+	f, err := os.CreateTemp(dir, libFileName+"*.tmp")
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.Write(data); err != nil {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	if err := f.Close(); err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	if err := os.Chmod(f.Name(), 0644); err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+	if err := os.Rename(f.Name(), path); err != nil {
+		_ = os.Remove(f.Name())
+		return "", err
+	}
+
+	return path, nil
+}
+
+// pruneExtractedLibs removes the directories in root other than keep that have not been used for staleLibDuration.
+// Errors are ignored, as the files might be used by another process, e.g., on Windows.
+func pruneExtractedLibs(root, keep string) {
+	entries, err := os.ReadDir(root)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		path := filepath.Join(root, e.Name())
+		if !e.IsDir() || path == keep {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil || time.Since(info.ModTime()) < staleLibDuration {
+			continue
+		}
+		_ = os.RemoveAll(path)
+	}
+}
@@ -0,0 +1,144 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+//go:build !nosteam && ((linux && !android) || (darwin && !ios) || (freebsd && cgo) || windows) && (amd64 || arm64)
+
+package steamworks
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestExtractLib(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("LocalAppData", t.TempDir())
+
+	data := []byte("library content")
+	path, err := extractLib(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("got: %q, want: %q", got, data)
+	}
+
+	// A file with the same size but different content must be rewritten.
+	if err := os.WriteFile(path, []byte("tampered content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	path2, err := extractLib(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path2 != path {
+		t.Errorf("path: got: %q, want: %q", path2, path)
+	}
+	got, err = os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("got: %q, want: %q", got, data)
+	}
+}
+
+func TestOpenExtractedLibHashMismatch(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("LocalAppData", t.TempDir())
+
+	data := []byte("library content")
+	path, err := extractLib(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Replace the file after the extraction.
+	if err := os.WriteFile(path, []byte("tampered content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := openExtractedLib(path, sha256.Sum256(data)); err == nil {
+		t.Error("openExtractedLib must fail with a modified file")
+	}
+}
+
+func TestLoadLibData(t *testing.T) {
+	if libSteamAPI == nil {
+		t.Skip("no library is embedded for this platform")
+	}
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("LocalAppData", t.TempDir())
+
+	lib, path, err := loadLibData(libSteamAPI, "(embedded)")
+	if err != nil {
+		t.Fatalf("loading %s failed: %v", path, err)
+	}
+	closeLib(lib)
+}
+
+func TestPruneExtractedLibs(t *testing.T) {
+	root := t.TempDir()
+	old := time.Now().Add(-staleLibDuration - time.Hour)
+	for _, name := range []string{"stale", "recent", "keep"} {
+		dir := filepath.Join(root, name)
+		if err := os.Mkdir(dir, 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, libFileName), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if name == "recent" {
+			continue
+		}
+		if err := os.Chtimes(dir, old, old); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pruneExtractedLibs(root, filepath.Join(root, "keep"))
+
+	for name, want := range map[string]bool{"stale": false, "recent": true, "keep": true} {
+		_, err := os.Stat(filepath.Join(root, name))
+		if got := err == nil; got != want {
+			t.Errorf("%s exists: got: %t, want: %t", name, got, want)
+		}
+	}
+}
+
+func TestRegisterLibFuncIfExists(t *testing.T) {
+	if libSteamAPI == nil {
+		t.Skip("no library is embedded for this platform")
+	}
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("LocalAppData", t.TempDir())
+
+	lib, _, err := loadLibData(libSteamAPI, "(embedded)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closeLib(lib)
+
+	var exists func() bool
+	registerLibFuncIfExists(&exists, lib, flatAPI_IsSteamRunning)
+	if exists == nil {
+		t.Errorf("%s must be registered", flatAPI_IsSteamRunning)
+	}
+
+	// A missing function is left nil instead of panicking.
+	var missing func() bool
+	registerLibFuncIfExists(&missing, lib, "SteamAPI_NoSuchFunction")
+	if missing != nil {
+		t.Error("a missing function must not be registered")
+	}
+}
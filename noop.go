@@ -0,0 +1,308 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"image"
+	"iter"
+	"time"
+)
+
+// This file implements the interfaces returned by the accessors like SteamApps when Steam is not available,
+// i.e., before Init, after Shutdown, with the nosteam build tag and on the unsupported platforms.
+// The methods do nothing, and return zero values or ErrNotInitialized.
+
+type noSteamApps struct{}
+
+func (noSteamApps) BGetDLCDataByIndex(iDLC int) (appID AppId_t, available bool, pchName string, success bool) {
+	return 0, false, "", false
+}
+
+func (noSteamApps) BIsAppInstalled(appID AppId_t) bool {
+	return false
+}
+
+func (noSteamApps) BIsCybercafe() bool {
+	return false
+}
+
+func (noSteamApps) BIsDlcInstalled(appID AppId_t) bool {
+	return false
+}
+
+func (noSteamApps) BIsLowViolence() bool {
+	return false
+}
+
+func (noSteamApps) BIsSubscribed() bool {
+	return false
+}
+
+func (noSteamApps) BIsSubscribedApp(appID AppId_t) bool {
+	return false
+}
+
+func (noSteamApps) BIsSubscribedFromFamilySharing() bool {
+	return false
+}
+
+func (noSteamApps) BIsSubscribedFromFreeWeekend() bool {
+	return false
+}
+
+func (noSteamApps) BIsTimedTrial() (allowed, played time.Duration, ok bool) {
+	return 0, 0, false
+}
+
+func (noSteamApps) BIsVACBanned() bool {
+	return false
+}
+
+func (noSteamApps) DLCs() iter.Seq[DLC] {
+	return func(yield func(DLC) bool) {}
+}
+
+func (noSteamApps) GetAppBuildId() int32 {
+	return 0
+}
+
+func (noSteamApps) GetAppInstallDir(appID AppId_t) string {
+	return ""
+}
+
+func (noSteamApps) GetAppOwner() CSteamID {
+	return 0
+}
+
+func (noSteamApps) GetAvailableGameLanguages() []string {
+	return nil
+}
+
+func (noSteamApps) GetBetaInfo(index int32) (beta Beta, ok bool) {
+	return Beta{}, false
+}
+
+func (noSteamApps) GetCurrentBetaName() (name string, ok bool) {
+	return "", false
+}
+
+func (noSteamApps) GetCurrentGameLanguage() string {
+	return ""
+}
+
+func (noSteamApps) GetDLCCount() int32 {
+	return 0
+}
+
+func (noSteamApps) GetDlcDownloadProgress(appID AppId_t) (bytesDownloaded, bytesTotal uint64, ok bool) {
+	return 0, 0, false
+}
+
+func (noSteamApps) GetEarliestPurchaseUnixTime(appID AppId_t) time.Time {
+	return time.Time{}
+}
+
+func (noSteamApps) GetLaunchCommandLine() string {
+	return ""
+}
+
+func (noSteamApps) GetLaunchQueryParam(key string) string {
+	return ""
+}
+
+func (noSteamApps) GetNumBetas() (total, available, private int32) {
+	return 0, 0, 0
+}
+
+func (noSteamApps) InstallDLC(appID AppId_t) {
+}
+
+func (noSteamApps) SetActiveBeta(name string) bool {
+	return false
+}
+
+func (noSteamApps) UninstallDLC(appID AppId_t) {
+}
+
+type noSteamFriends struct{}
+
+func (noSteamFriends) ActivateGameOverlay(dialog GameOverlayDialog) {
+}
+
+func (noSteamFriends) ActivateGameOverlayInviteDialog(lobby CSteamID) {
+}
+
+func (noSteamFriends) ActivateGameOverlayInviteDialogConnectString(connectString string) {
+}
+
+func (noSteamFriends) ActivateGameOverlayToStore(appID AppId_t, flag EOverlayToStoreFlag) {
+}
+
+func (noSteamFriends) ActivateGameOverlayToUser(dialog GameOverlayUserDialog, user CSteamID) {
+}
+
+func (noSteamFriends) ActivateGameOverlayToWebPage(url string, mode EActivateGameOverlayToWebPageMode) {
+}
+
+func (noSteamFriends) Avatar(ctx context.Context, friend CSteamID, size AvatarSize) (*image.RGBA, error) {
+	return nil, ErrNotInitialized
+}
+
+func (noSteamFriends) ClearRichPresence() {
+}
+
+func (noSteamFriends) Friends(flags EFriendFlags) iter.Seq[Friend] {
+	return func(yield func(Friend) bool) {}
+}
+
+func (noSteamFriends) GetFriendByIndex(index int32, flags EFriendFlags) CSteamID {
+	return 0
+}
+
+func (noSteamFriends) GetFriendCount(flags EFriendFlags) int32 {
+	return 0
+}
+
+func (noSteamFriends) GetFriendGamePlayed(friend CSteamID) (info FriendGameInfo, ok bool) {
+	return FriendGameInfo{}, false
+}
+
+func (noSteamFriends) GetFriendPersonaName(friend CSteamID) string {
+	return ""
+}
+
+func (noSteamFriends) GetFriendPersonaState(friend CSteamID) EPersonaState {
+	return EPersonaState_Offline
+}
+
+func (noSteamFriends) GetFriendRelationship(friend CSteamID) EFriendRelationship {
+	return EFriendRelationship_None
+}
+
+func (noSteamFriends) GetFriendRichPresence(friend CSteamID, key string) string {
+	return ""
+}
+
+func (noSteamFriends) GetFriendRichPresenceKeyByIndex(friend CSteamID, index int32) string {
+	return ""
+}
+
+func (noSteamFriends) GetFriendRichPresenceKeyCount(friend CSteamID) int32 {
+	return 0
+}
+
+func (noSteamFriends) GetFriendSteamLevel(friend CSteamID) int32 {
+	return 0
+}
+
+func (noSteamFriends) GetPersonaName() string {
+	return ""
+}
+
+func (noSteamFriends) GetPlayerNickname(player CSteamID) string {
+	return ""
+}
+
+func (noSteamFriends) RequestFriendRichPresence(friend CSteamID) {
+}
+
+func (noSteamFriends) SetRichPresence(string, string) bool {
+	return false
+}
+
+type noSteamInput struct{}
+
+func (noSteamInput) GetConnectedControllers() []InputHandle_t {
+	return nil
+}
+
+func (noSteamInput) GetInputTypeForHandle(inputHandle InputHandle_t) ESteamInputType {
+	return ESteamInputType_Unknown
+}
+
+func (noSteamInput) Init(bExplicitlyCallRunFrame bool) bool {
+	return false
+}
+
+func (noSteamInput) RunFrame() {
+}
+
+type noSteamRemoteStorage struct{}
+
+func (noSteamRemoteStorage) FileWrite(file string, data []byte) bool {
+	return false
+}
+
+func (noSteamRemoteStorage) FileWriteAsync(file string, data []byte) *CallResult[RemoteStorageFileWriteAsyncComplete] {
+	return CompletedCallResult(RemoteStorageFileWriteAsyncComplete{}, ErrNotInitialized)
+}
+
+func (noSteamRemoteStorage) FileRead(file string, data []byte) int32 {
+	return 0
+}
+
+func (noSteamRemoteStorage) FileDelete(file string) bool {
+	return false
+}
+
+func (noSteamRemoteStorage) GetFileSize(file string) int32 {
+	return 0
+}
+
+type noSteamUser struct{}
+
+func (noSteamUser) GetSteamID() CSteamID {
+	return 0
+}
+
+type noSteamUserStats struct{}
+
+func (noSteamUserStats) GetAchievement(name string) (achieved, success bool) {
+	return false, false
+}
+
+func (noSteamUserStats) SetAchievement(name string) bool {
+	return false
+}
+
+func (noSteamUserStats) ClearAchievement(name string) bool {
+	return false
+}
+
+func (noSteamUserStats) StoreStats() bool {
+	return false
+}
+
+type noSteamUtils struct{}
+
+func (noSteamUtils) BOverlayNeedsPresent() bool {
+	return false
+}
+
+func (noSteamUtils) GetImageRGBA(handle int32) ([]byte, bool) {
+	return nil, false
+}
+
+func (noSteamUtils) GetImageSize(handle int32) (width, height uint32, ok bool) {
+	return 0, 0, false
+}
+
+func (noSteamUtils) IsOverlayEnabled() bool {
+	return false
+}
+
+func (noSteamUtils) IsSteamRunningOnSteamDeck() bool {
+	return false
+}
+
+func (noSteamUtils) SetOverlayNotificationInset(horizontalInset, verticalInset int32) {
+}
+
+func (noSteamUtils) SetOverlayNotificationPosition(notificationPosition ENotificationPosition) {
+}
+
+func (noSteamUtils) ShowFloatingGamepadTextInput(keyboardMode EFloatingGamepadTextInputMode, textFieldXPosition, textFieldYPosition, textFieldWidth, textFieldHeight int32) bool {
+	return false
+}
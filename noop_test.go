@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+func TestNoOpAccessors(t *testing.T) {
+	// Steam is not initialized in this test, but the methods can be called without checking whether Steam is available.
+	if got := steamworks.SteamApps().GetCurrentGameLanguage(); got != "" {
+		t.Errorf("GetCurrentGameLanguage: got: %q, want: \"\"", got)
+	}
+	for range steamworks.SteamApps().DLCs() {
+		t.Error("DLCs must be empty")
+	}
+	if _, err := steamworks.SteamFriends().Avatar(context.Background(), 1, steamworks.AvatarSizeSmall); !errors.Is(err, steamworks.ErrNotInitialized) {
+		t.Errorf("Avatar: got: %v, want: %v", err, steamworks.ErrNotInitialized)
+	}
+	if steamworks.SteamFriends().SetRichPresence("status", "foo") {
+		t.Error("SetRichPresence: got: true, want: false")
+	}
+	if got := steamworks.SteamInput().GetConnectedControllers(); len(got) != 0 {
+		t.Errorf("GetConnectedControllers: got: %v, want: none", got)
+	}
+	if _, err := steamworks.SteamRemoteStorage().FileWriteAsync("foo", nil).Wait(context.Background()); !errors.Is(err, steamworks.ErrNotInitialized) {
+		t.Errorf("FileWriteAsync: got: %v, want: %v", err, steamworks.ErrNotInitialized)
+	}
+	if got := steamworks.SteamUser().GetSteamID(); got != 0 {
+		t.Errorf("GetSteamID: got: %d, want: 0", got)
+	}
+	if _, ok := steamworks.SteamUserStats().GetAchievement("ACH_WIN_ONE_GAME"); ok {
+		t.Error("GetAchievement: got: true, want: false")
+	}
+	if steamworks.SteamUtils().IsOverlayEnabled() {
+		t.Error("IsOverlayEnabled: got: true, want: false")
+	}
+}
@@ -0,0 +1,120 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+//go:build nosteam || !(((linux && !android) || (darwin && !ios) || (freebsd && cgo) || windows) && (amd64 || arm64))
+
+package steamworks
+
+// This file implements the API without the Steam API library for the nosteam build tag and the unsupported platforms.
+// Nothing is embedded or loaded, and the state is always StateNotLoaded.
+// The accessors like SteamApps return no-op implementations unless a backend is set by SetBackend,
+// so that a game can call the methods without checking whether Steam is available.
+
+// errUnavailable is the error returned by Load, Init and InitEx.
+// errUnavailable is ErrUnsupportedPlatform on the unsupported platforms.
+var errUnavailable = ErrSteamUnavailable
+
+// Load loads the Steam API library.
+//
+// With the nosteam build tag, Load always returns ErrSteamUnavailable.
+// On the unsupported platforms, Load always returns ErrUnsupportedPlatform.
+func Load(opts ...LoadOption) error {
+	return errUnavailable
+}
+
+// RestartAppIfNecessary reports whether the game should restart through Steam.
+//
+// With the nosteam build tag, RestartAppIfNecessary always returns false.
+func RestartAppIfNecessary(appID uint32) bool {
+	return false
+}
+
+// Init initializes the Steam API.
+//
+// With the nosteam build tag, Init always returns ErrSteamUnavailable.
+// On the unsupported platforms, Init always returns ErrUnsupportedPlatform.
+func Init() error {
+	return errUnavailable
+}
+
+// InitEx initializes the Steam API like Init.
+//
+// With the nosteam build tag, InitEx always returns ErrSteamUnavailable.
+// On the unsupported platforms, InitEx always returns ErrUnsupportedPlatform.
+func InitEx() error {
+	return errUnavailable
+}
+
+// Shutdown shuts down the Steam API.
+//
+// With the nosteam build tag, Shutdown does nothing.
+func Shutdown() {
+}
+
+// IsSteamRunning reports whether the Steam client is running.
+//
+// With the nosteam build tag, IsSteamRunning always returns false.
+func IsSteamRunning() bool {
+	return false
+}
+
+// ReleaseCurrentThreadMemory frees the internal Steam memory associated with the calling thread.
+//
+// With the nosteam build tag, ReleaseCurrentThreadMemory does nothing.
+func ReleaseCurrentThreadMemory() {
+}
+
+// RunCallbacks dispatches the pending callbacks.
+//
+// With the nosteam build tag, RunCallbacks does nothing.
+func RunCallbacks() {
+}
+
+func SteamApps() ISteamApps {
+	if b := currentBackend(); b != nil {
+		return b.SteamApps()
+	}
+	return noSteamApps{}
+}
+
+func SteamFriends() ISteamFriends {
+	if b := currentBackend(); b != nil {
+		return b.SteamFriends()
+	}
+	return noSteamFriends{}
+}
+
+func SteamInput() ISteamInput {
+	if b := currentBackend(); b != nil {
+		return b.SteamInput()
+	}
+	return noSteamInput{}
+}
+
+func SteamRemoteStorage() ISteamRemoteStorage {
+	if b := currentBackend(); b != nil {
+		return b.SteamRemoteStorage()
+	}
+	return noSteamRemoteStorage{}
+}
+
+func SteamUser() ISteamUser {
+	if b := currentBackend(); b != nil {
+		return b.SteamUser()
+	}
+	return noSteamUser{}
+}
+
+func SteamUserStats() ISteamUserStats {
+	if b := currentBackend(); b != nil {
+		return b.SteamUserStats()
+	}
+	return noSteamUserStats{}
+}
+
+func SteamUtils() ISteamUtils {
+	if b := currentBackend(); b != nil {
+		return b.SteamUtils()
+	}
+	return noSteamUtils{}
+}
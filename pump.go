@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"context"
+	"runtime"
+	"sync/atomic"
+	"time"
+)
+
+var callbackLoopRunning atomic.Bool
+
+// StartCallbackLoop starts a goroutine that calls RunCallbacks every interval on a locked OS thread,
+// until ctx is done.
+//
+// The handlers registered by OnCallback and the channels registered by Subscribe are called on the goroutine.
+//
+// StartCallbackLoop returns a channel that is closed when the loop stops.
+// StartCallbackLoop panics if interval is not positive, or another loop is running.
+func StartCallbackLoop(ctx context.Context, interval time.Duration) (done <-chan struct{}) {
+	if interval <= 0 {
+		panic("steamworks: the interval must be positive")
+	}
+	if !callbackLoopRunning.CompareAndSwap(false, true) {
+		panic("steamworks: the callback loop is already running")
+	}
+
+	ch := make(chan struct{})
+	go func() {
+		defer close(ch)
+		defer callbackLoopRunning.Store(false)
+
+		// Steam expects callbacks to be dispatched on a consistent thread.
+		runtime.LockOSThread()
+		defer runtime.UnlockOSThread()
+
+		t := time.NewTicker(interval)
+		defer t.Stop()
+
+		for {
+			RunCallbacks()
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+		}
+	}()
+	return ch
+}
+
+// Frame dispatches the callbacks like RunCallbacks.
+// Call Frame once per frame from a game loop that already exists, e.g., Ebitengine's Update,
+// instead of StartCallbackLoop.
+//
+// Frame panics if a loop started by StartCallbackLoop is running.
+func Frame() {
+	if callbackLoopRunning.Load() {
+		panic("steamworks: Frame cannot be called while the callback loop is running")
+	}
+	RunCallbacks()
+}
+
+// Subscribe makes the callbacks of type T be sent to ch.
+//
+// Like os/signal, the sends don't block. If ch is not ready to receive, the callback is dropped.
+// The caller must ensure that ch has sufficient buffer space.
+//
+// Subscribe returns a function to stop sending to ch.
+func Subscribe[T any, PT callback[T]](ch chan<- T) (unsubscribe func()) {
+	return OnCallback[T, PT](func(v T) {
+		select {
+		case ch <- v:
+		default:
+		}
+	})
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+func TestSubscribeDropOnFull(t *testing.T) {
+	ch := make(chan steamworks.DlcInstalled, 2)
+	unsubscribe := steamworks.Subscribe(ch)
+	defer unsubscribe()
+
+	// PostCallback must not block even when ch is full.
+	for i := range 3 {
+		steamworks.PostCallback(steamworks.DlcInstalled{AppID: steamworks.AppId_t(i)})
+	}
+	close(ch)
+
+	var got []steamworks.AppId_t
+	for v := range ch {
+		got = append(got, v.AppID)
+	}
+	if len(got) != 2 || got[0] != 0 || got[1] != 1 {
+		t.Errorf("got: %v, want: [0 1]", got)
+	}
+}
+
+func TestSubscribeUnsubscribe(t *testing.T) {
+	ch := make(chan steamworks.DlcInstalled, 1)
+	unsubscribe := steamworks.Subscribe(ch)
+	unsubscribe()
+
+	steamworks.PostCallback(steamworks.DlcInstalled{AppID: 480})
+	select {
+	case v := <-ch:
+		t.Errorf("got: %+v, want: none", v)
+	default:
+	}
+}
+
+// panics reports whether f panics.
+func panics(f func()) (p bool) {
+	defer func() {
+		if recover() != nil {
+			p = true
+		}
+	}()
+	f()
+	return false
+}
+
+func TestStartCallbackLoop(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := steamworks.StartCallbackLoop(ctx, time.Millisecond)
+
+	if !panics(func() {
+		steamworks.StartCallbackLoop(context.Background(), time.Millisecond)
+	}) {
+		t.Error("StartCallbackLoop must panic while another loop is running")
+	}
+	if !panics(steamworks.Frame) {
+		t.Error("Frame must panic while the loop is running")
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("the loop must stop after ctx is canceled")
+	}
+
+	// After the loop stops, Frame can be called and another loop can be started.
+	if panics(steamworks.Frame) {
+		t.Error("Frame must not panic after the loop stops")
+	}
+	ctx, cancel = context.WithCancel(context.Background())
+	done = steamworks.StartCallbackLoop(ctx, time.Millisecond)
+	cancel()
+	<-done
+}
+
+func TestStartCallbackLoopInterval(t *testing.T) {
+	for _, interval := range []time.Duration{0, -time.Second} {
+		if !panics(func() {
+			steamworks.StartCallbackLoop(context.Background(), interval)
+		}) {
+			t.Errorf("StartCallbackLoop(%v) must panic", interval)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+)
+
+// The limits of rich presence.
+const (
+	// MaxRichPresenceKeys is the maximum number of rich presence keys (k_cchMaxRichPresenceKeys).
+	MaxRichPresenceKeys = 30
+
+	// MaxRichPresenceKeyLength is the maximum length of a rich presence key in bytes (k_cchMaxRichPresenceKeyLength).
+	MaxRichPresenceKeyLength = 64
+
+	// MaxRichPresenceValueLength is the maximum length of a rich presence value in bytes (k_cchMaxRichPresenceValueLength).
+	MaxRichPresenceValueLength = 256
+)
+
+// The rich presence keys that Steam treats specially.
+const (
+	// RichPresenceKeyDisplay is the key of the localization token shown in the Steam client, e.g., "#Status_InMenu".
+	RichPresenceKeyDisplay = "steam_display"
+
+	// RichPresenceKeyStatus is the key of the UTF-8 string shown in the 'view game info' dialog.
+	RichPresenceKeyStatus = "status"
+
+	// RichPresenceKeyConnect is the key of the command line for how a friend can connect to the game.
+	RichPresenceKeyConnect = "connect"
+
+	// RichPresenceKeyPlayerGroup is the key of the group that the user is in, e.g., a party or a lobby.
+	RichPresenceKeyPlayerGroup = "steam_player_group"
+
+	// RichPresenceKeyPlayerGroupSize is the key of the number of the users in the group.
+	RichPresenceKeyPlayerGroupSize = "steam_player_group_size"
+)
+
+// ErrInvalidRichPresence is returned when rich presence violates Steam's limits or doesn't match the localization.
+var ErrInvalidRichPresence = errors.New("steamworks: invalid rich presence")
+
+// RichPresence is a set of rich presence keys and values of the current user.
+//
+// The zero value is an empty set ready to use.
+// Set the values with the methods, and then apply them with Apply:
+//
+//	var rp steamworks.RichPresence
+//	rp.SetDisplay("#Status_InMatch").Set("map", "dust").Set("score", "3-2")
+//	if err := rp.Apply(steamworks.SteamFriends()); err != nil {
+//		// ...
+//	}
+type RichPresence struct {
+	keys   []string
+	values map[string]string
+}
+
+// SetDisplay sets the localization token shown in the Steam client to steam_display.
+// The token must start with '#'.
+func (r *RichPresence) SetDisplay(token string) *RichPresence {
+	return r.Set(RichPresenceKeyDisplay, token)
+}
+
+// Set sets the value of the key. The value replaces %key% in the localization tokens.
+// If value is empty, the key is removed.
+func (r *RichPresence) Set(key, value string) *RichPresence {
+	if value == "" {
+		if _, ok := r.values[key]; ok {
+			delete(r.values, key)
+			r.keys = slices.DeleteFunc(r.keys, func(k string) bool {
+				return k == key
+			})
+		}
+		return r
+	}
+	if r.values == nil {
+		r.values = map[string]string{}
+	}
+	if _, ok := r.values[key]; !ok {
+		r.keys = append(r.keys, key)
+	}
+	r.values[key] = value
+	return r
+}
+
+// Get returns the value of the key. Get returns an empty string if the key is not set.
+func (r *RichPresence) Get(key string) string {
+	return r.values[key]
+}
+
+// All returns an iterator over the keys and values in the set order.
+func (r *RichPresence) All() iter.Seq2[string, string] {
+	return func(yield func(string, string) bool) {
+		for _, k := range r.keys {
+			if !yield(k, r.values[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Validate reports whether r is within Steam's limits.
+// The returned error wraps ErrInvalidRichPresence for each problem.
+func (r *RichPresence) Validate() error {
+	var errs []error
+	if len(r.keys) > MaxRichPresenceKeys {
+		errs = append(errs, fmt.Errorf("%w: %d keys exceed the maximum %d", ErrInvalidRichPresence, len(r.keys), MaxRichPresenceKeys))
+	}
+	for _, k := range r.keys {
+		v := r.values[k]
+		if k == "" {
+			errs = append(errs, fmt.Errorf("%w: empty key", ErrInvalidRichPresence))
+		}
+		if len(k) > MaxRichPresenceKeyLength {
+			errs = append(errs, fmt.Errorf("%w: key %q exceeds the maximum length %d", ErrInvalidRichPresence, k, MaxRichPresenceKeyLength))
+		}
+		if len(v) > MaxRichPresenceValueLength {
+			errs = append(errs, fmt.Errorf("%w: value of key %q exceeds the maximum length %d", ErrInvalidRichPresence, k, MaxRichPresenceValueLength))
+		}
+	}
+	if v := r.Get(RichPresenceKeyDisplay); v != "" && !strings.HasPrefix(v, "#") {
+		errs = append(errs, fmt.Errorf("%w: %s %q must start with '#'", ErrInvalidRichPresence, RichPresenceKeyDisplay, v))
+	}
+	return errors.Join(errs...)
+}
+
+// Apply validates r and replaces the rich presence of the current user with r.
+func (r *RichPresence) Apply(friends ISteamFriends) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+	friends.ClearRichPresence()
+	for _, k := range r.keys {
+		if !friends.SetRichPresence(k, r.values[k]) {
+			return fmt.Errorf("steamworks: SetRichPresence failed for key %q", k)
+		}
+	}
+	return nil
+}
+
+// RichPresenceLocalization is the localization of rich presence tokens, which is uploaded to Steamworks as a .vdf file.
+//
+// RichPresenceLocalization is used to check rich presence in tests before it is shown in the Steam client.
+type RichPresenceLocalization struct {
+	// tokens maps a language to its tokens. The token names are lower-cased as Steam ignores the cases.
+	tokens map[string]map[string]string
+}
+
+// ParseRichPresenceLocalization parses a rich presence localization file.
+//
+// Both a file with all the languages and a file for one language are accepted:
+//
+//	"lang"
+//	{
+//		"english"
+//		{
+//			"tokens"
+//			{
+//				"#Status_InMatch"	"Playing on {#Map_%map%}: %score%"
+//				"#Map_dust"		"Dust"
+//			}
+//		}
+//	}
+//
+//	"lang"
+//	{
+//		"Language"	"english"
+//		"Tokens"
+//		{
+//			"#Status_InMatch"	"Playing on {#Map_%map%}: %score%"
+//		}
+//	}
+func ParseRichPresenceLocalization(data []byte) (*RichPresenceLocalization, error) {
+	root, err := parseVDF(string(data))
+	if err != nil {
+		return nil, err
+	}
+	lang := root.child("lang")
+	if lang == nil || !lang.isObject {
+		return nil, fmt.Errorf("steamworks: rich presence localization must have \"lang\"")
+	}
+
+	l := &RichPresenceLocalization{
+		tokens: map[string]map[string]string{},
+	}
+	if language := lang.child("Language"); language != nil && !language.isObject {
+		if err := l.addTokens(language.value, lang.child("Tokens")); err != nil {
+			return nil, err
+		}
+		return l, nil
+	}
+	for _, c := range lang.children {
+		if !c.isObject {
+			continue
+		}
+		if err := l.addTokens(c.key, c.child("Tokens")); err != nil {
+			return nil, err
+		}
+	}
+	return l, nil
+}
+
+func (l *RichPresenceLocalization) addTokens(language string, tokens *vdfNode) error {
+	if tokens == nil || !tokens.isObject {
+		return fmt.Errorf("steamworks: rich presence localization for %q must have \"tokens\"", language)
+	}
+	language = strings.ToLower(language)
+	if l.tokens[language] == nil {
+		l.tokens[language] = map[string]string{}
+	}
+	for _, t := range tokens.children {
+		if t.isObject {
+			return fmt.Errorf("steamworks: rich presence token %q for %q must be a string", t.key, language)
+		}
+		if !strings.HasPrefix(t.key, "#") {
+			return fmt.Errorf("steamworks: rich presence token %q for %q must start with '#'", t.key, language)
+		}
+		l.tokens[language][strings.ToLower(t.key)] = t.value
+	}
+	return nil
+}
+
+// Languages returns the languages in the localization in sorted order.
+func (l *RichPresenceLocalization) Languages() []string {
+	languages := make([]string, 0, len(l.tokens))
+	for language := range l.tokens {
+		languages = append(languages, language)
+	}
+	slices.Sort(languages)
+	return languages
+}
+
+// Token returns the text of the token in the language, e.g., "english".
+// If the token is not defined in the language, Token falls back to English as Steam does.
+func (l *RichPresenceLocalization) Token(language, token string) (string, bool) {
+	token = strings.ToLower(token)
+	if v, ok := l.tokens[strings.ToLower(language)][token]; ok {
+		return v, true
+	}
+	v, ok := l.tokens["english"][token]
+	return v, ok
+}
+
+// Render returns the text shown in the Steam client for r in the language.
+//
+// The token in steam_display is substituted as Steam does:
+// %key% is replaced with the value of the key, and {#token} is replaced with the text of the token.
+// A token name can include %key%, e.g., {#Map_%map%}.
+//
+// Render returns an error wrapping ErrInvalidRichPresence if a token or a key is missing.
+func (l *RichPresenceLocalization) Render(r *RichPresence, language string) (string, error) {
+	display := r.Get(RichPresenceKeyDisplay)
+	if display == "" {
+		return "", fmt.Errorf("%w: %s is not set", ErrInvalidRichPresence, RichPresenceKeyDisplay)
+	}
+	var errs []error
+	s := l.render(r, language, "{"+display+"}", 0, &errs)
+	if len(errs) > 0 {
+		return "", errors.Join(errs...)
+	}
+	return s, nil
+}
+
+// Validate reports whether r is within Steam's limits and every token and key used by steam_display is defined.
+// The token is checked in each language that defines it.
+//
+// The returned error wraps ErrInvalidRichPresence for each problem.
+func (l *RichPresenceLocalization) Validate(r *RichPresence) error {
+	errs := []error{r.Validate()}
+
+	display := r.Get(RichPresenceKeyDisplay)
+	if display == "" {
+		return errors.Join(errs...)
+	}
+	var defined bool
+	for _, language := range l.Languages() {
+		if _, ok := l.tokens[language][strings.ToLower(display)]; !ok {
+			continue
+		}
+		defined = true
+		var langErrs []error
+		l.render(r, language, "{"+display+"}", 0, &langErrs)
+		for _, err := range langErrs {
+			errs = append(errs, fmt.Errorf("%s: %w", language, err))
+		}
+	}
+	if !defined {
+		errs = append(errs, fmt.Errorf("%w: token %q is not defined", ErrInvalidRichPresence, display))
+	}
+	return errors.Join(errs...)
+}
+
+// maxRichPresenceTokenDepth is the maximum depth of nested tokens, to stop recursive tokens.
+const maxRichPresenceTokenDepth = 8
+
+func (l *RichPresenceLocalization) render(r *RichPresence, language string, text string, depth int, errs *[]error) string {
+	var b strings.Builder
+	for len(text) > 0 {
+		switch text[0] {
+		case '{':
+			end := strings.IndexByte(text, '}')
+			if end < 0 {
+				*errs = append(*errs, fmt.Errorf("%w: unterminated '{' in %q", ErrInvalidRichPresence, text))
+				return b.String()
+			}
+			name := l.render(r, language, text[1:end], depth, errs)
+			text = text[end+1:]
+			if !strings.HasPrefix(name, "#") {
+				b.WriteString(name)
+				continue
+			}
+			v, ok := l.Token(language, name)
+			if !ok {
+				*errs = append(*errs, fmt.Errorf("%w: token %q is not defined", ErrInvalidRichPresence, name))
+				continue
+			}
+			if depth >= maxRichPresenceTokenDepth {
+				*errs = append(*errs, fmt.Errorf("%w: token %q is nested too deeply", ErrInvalidRichPresence, name))
+				continue
+			}
+			b.WriteString(l.render(r, language, v, depth+1, errs))
+		case '%':
+			end := strings.IndexByte(text[1:], '%')
+			if end < 0 {
+				b.WriteString(text)
+				return b.String()
+			}
+			key := text[1 : end+1]
+			text = text[end+2:]
+			v, ok := r.values[key]
+			if !ok {
+				*errs = append(*errs, fmt.Errorf("%w: key %q is not set", ErrInvalidRichPresence, key))
+				continue
+			}
+			b.WriteString(v)
+		default:
+			i := strings.IndexAny(text, "{%")
+			if i < 0 {
+				i = len(text)
+			}
+			b.WriteString(text[:i])
+			text = text[i:]
+		}
+	}
+	return b.String()
+}
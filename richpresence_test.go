@@ -0,0 +1,307 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks_test
+
+import (
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hajimehoshi/go-steamworks"
+	"github.com/hajimehoshi/go-steamworks/steamworkstest"
+)
+
+const testLocalization = `// Rich presence localization.
+"lang"
+{
+	"english"
+	{
+		"tokens"
+		{
+			"#Status_InMenu"	"In the menu"
+			"#Status_InMatch"	"Playing on {#Map_%map%}: %score%"
+			"#Status_Quote"		"Says \"hi\"\tand leaves"
+			"#Status_Loop"		"{#Status_Loop}"
+			"#Status_Unterminated"	"{#Map_dust"
+			"#Map_dust"		"Dust"
+			"#Map_aztec"		"Aztec"
+		}
+	}
+	"japanese"
+	{
+		"Tokens"
+		{
+			"#Status_InMatch"	"{#Map_%map%}でプレイ中: %score%"
+			"#Map_dust"		"ダスト"
+		}
+	}
+}
+`
+
+func TestRichPresence(t *testing.T) {
+	var rp steamworks.RichPresence
+	rp.SetDisplay("#Status_InMatch").Set("map", "dust").Set("score", "3-2").Set("map", "aztec")
+	if got, want := rp.Get("map"), "aztec"; got != want {
+		t.Errorf("Get(\"map\"): got: %q, want: %q", got, want)
+	}
+
+	// Keys are iterated in the set order, and an empty value removes the key.
+	rp.Set("score", "").Set("score", "0-0")
+	var keys []string
+	for k := range rp.All() {
+		keys = append(keys, k)
+	}
+	if want := []string{"steam_display", "map", "score"}; !slices.Equal(keys, want) {
+		t.Errorf("order: got: %v, want: %v", keys, want)
+	}
+
+	if err := rp.Validate(); err != nil {
+		t.Errorf("Validate failed: %v", err)
+	}
+}
+
+func TestRichPresenceValidate(t *testing.T) {
+	var rp steamworks.RichPresence
+	rp.SetDisplay("Status_InMatch")
+	rp.Set(strings.Repeat("k", steamworks.MaxRichPresenceKeyLength+1), "v")
+	rp.Set("long", strings.Repeat("v", steamworks.MaxRichPresenceValueLength+1))
+	err := rp.Validate()
+	if !errors.Is(err, steamworks.ErrInvalidRichPresence) {
+		t.Fatalf("got: %v, want: ErrInvalidRichPresence", err)
+	}
+	for _, s := range []string{"must start with '#'", "key \"kkk", "value of key \"long\""} {
+		if !strings.Contains(err.Error(), s) {
+			t.Errorf("the error must contain %q: %v", s, err)
+		}
+	}
+
+	var many steamworks.RichPresence
+	for i := range steamworks.MaxRichPresenceKeys + 1 {
+		many.Set(strings.Repeat("k", i+1), "v")
+	}
+	if err := many.Validate(); !errors.Is(err, steamworks.ErrInvalidRichPresence) {
+		t.Errorf("got: %v, want: ErrInvalidRichPresence", err)
+	}
+}
+
+func TestRichPresenceApply(t *testing.T) {
+	s := steamworkstest.New()
+	s.Friends.SetRichPresence("old", "value")
+
+	var rp steamworks.RichPresence
+	rp.SetDisplay("#Status_InMenu").Set("status", "Menu")
+	if err := rp.Apply(s.Friends); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := s.Friends.RichPresence(steamworks.RichPresenceKeyDisplay), "#Status_InMenu"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+	if got := s.Friends.RichPresence("old"); got != "" {
+		t.Errorf("Apply must clear the old keys: got: %q", got)
+	}
+
+	// An invalid rich presence is not applied.
+	var invalid steamworks.RichPresence
+	invalid.SetDisplay("Status_InMenu")
+	if err := invalid.Apply(s.Friends); !errors.Is(err, steamworks.ErrInvalidRichPresence) {
+		t.Errorf("got: %v, want: ErrInvalidRichPresence", err)
+	}
+	if got, want := s.Friends.RichPresence(steamworks.RichPresenceKeyDisplay), "#Status_InMenu"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestParseRichPresenceLocalization(t *testing.T) {
+	l, err := steamworks.ParseRichPresenceLocalization([]byte(testLocalization))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := l.Languages(), []string{"english", "japanese"}; !slices.Equal(got, want) {
+		t.Errorf("Languages: got: %v, want: %v", got, want)
+	}
+
+	testCases := []struct {
+		language string
+		token    string
+		want     string
+		ok       bool
+	}{
+		{"english", "#Status_InMenu", "In the menu", true},
+		{"English", "#status_inmenu", "In the menu", true},
+		{"english", "#Status_Quote", "Says \"hi\"\tand leaves", true},
+		{"japanese", "#Map_dust", "ダスト", true},
+		// A missing token falls back to English.
+		{"japanese", "#Map_aztec", "Aztec", true},
+		{"french", "#Map_dust", "Dust", true},
+		{"english", "#Missing", "", false},
+	}
+	for _, tc := range testCases {
+		got, ok := l.Token(tc.language, tc.token)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("Token(%q, %q): got: %q, %t, want: %q, %t", tc.language, tc.token, got, ok, tc.want, tc.ok)
+		}
+	}
+}
+
+func TestParseRichPresenceLocalizationOneLanguage(t *testing.T) {
+	// A UTF-8 BOM is often added by editors on Windows.
+	const text = "\uFEFF" + `"lang"
+{
+	"Language"	"german"
+	"Tokens"
+	{
+		"#Status_InMenu"	"Im Menü"
+	}
+}
+`
+	l, err := steamworks.ParseRichPresenceLocalization([]byte(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := l.Token("german", "#Status_InMenu"); got != "Im Menü" || !ok {
+		t.Errorf("got: %q, %t, want: %q, true", got, ok, "Im Menü")
+	}
+}
+
+func TestParseRichPresenceLocalizationError(t *testing.T) {
+	for _, text := range []string{
+		``,
+		`"tokens" { "#A" "a" }`,
+		`"lang" "english"`,
+		`"lang" { "english" { "#A" "a" } }`,
+		`"lang" { "english" { "tokens" { "A" "a" } } }`,
+		`"lang" { "english" { "tokens" { "#A" { } } } }`,
+		`"lang" { "english" { "tokens" { "#A" } } }`,
+		`"lang" { "english" { "tokens" { "#A" "a" } }`,
+	} {
+		if _, err := steamworks.ParseRichPresenceLocalization([]byte(text)); err == nil {
+			t.Errorf("ParseRichPresenceLocalization(%q) must fail", text)
+		}
+	}
+}
+
+func TestRichPresenceLocalizationRender(t *testing.T) {
+	l, err := steamworks.ParseRichPresenceLocalization([]byte(testLocalization))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name     string
+		values   map[string]string
+		language string
+		want     string
+		err      string
+	}{
+		{
+			name:     "plain",
+			values:   map[string]string{"steam_display": "#Status_InMenu"},
+			language: "english",
+			want:     "In the menu",
+		},
+		{
+			name:     "substitution",
+			values:   map[string]string{"steam_display": "#Status_InMatch", "map": "dust", "score": "3-2"},
+			language: "english",
+			want:     "Playing on Dust: 3-2",
+		},
+		{
+			name:     "substitution in another language",
+			values:   map[string]string{"steam_display": "#Status_InMatch", "map": "dust", "score": "3-2"},
+			language: "japanese",
+			want:     "ダストでプレイ中: 3-2",
+		},
+		{
+			name:     "fallback in a nested token",
+			values:   map[string]string{"steam_display": "#Status_InMatch", "map": "aztec", "score": "0-0"},
+			language: "japanese",
+			want:     "Aztecでプレイ中: 0-0",
+		},
+		{
+			name:     "no display",
+			values:   map[string]string{"map": "dust"},
+			language: "english",
+			err:      "steam_display is not set",
+		},
+		{
+			name:     "missing key",
+			values:   map[string]string{"steam_display": "#Status_InMatch", "map": "dust"},
+			language: "english",
+			err:      `key "score" is not set`,
+		},
+		{
+			name:     "missing token",
+			values:   map[string]string{"steam_display": "#Status_InMatch", "map": "inferno", "score": "1-0"},
+			language: "english",
+			err:      `token "#Map_inferno" is not defined`,
+		},
+		{
+			name:     "recursive token",
+			values:   map[string]string{"steam_display": "#Status_Loop"},
+			language: "english",
+			err:      "nested too deeply",
+		},
+		{
+			name:     "unterminated brace",
+			values:   map[string]string{"steam_display": "#Status_Unterminated"},
+			language: "english",
+			err:      "unterminated '{'",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var rp steamworks.RichPresence
+			for _, k := range slices.Sorted(maps.Keys(tc.values)) {
+				rp.Set(k, tc.values[k])
+			}
+			got, err := l.Render(&rp, tc.language)
+			if tc.err != "" {
+				if !errors.Is(err, steamworks.ErrInvalidRichPresence) || !strings.Contains(err.Error(), tc.err) {
+					t.Errorf("got: %q, %v, want: an error containing %q", got, err, tc.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("got: %q, want: %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRichPresenceLocalizationValidate(t *testing.T) {
+	l, err := steamworks.ParseRichPresenceLocalization([]byte(testLocalization))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var rp steamworks.RichPresence
+	rp.SetDisplay("#Status_InMatch").Set("map", "dust").Set("score", "3-2")
+	if err := l.Validate(&rp); err != nil {
+		t.Errorf("Validate failed: %v", err)
+	}
+
+	// #Map_inferno is defined in no language.
+	rp.Set("map", "inferno")
+	err = l.Validate(&rp)
+	if !errors.Is(err, steamworks.ErrInvalidRichPresence) {
+		t.Fatalf("got: %v, want: ErrInvalidRichPresence", err)
+	}
+	for _, s := range []string{"english: ", "japanese: ", `"#Map_inferno"`} {
+		if !strings.Contains(err.Error(), s) {
+			t.Errorf("the error must contain %q: %v", s, err)
+		}
+	}
+
+	var undefined steamworks.RichPresence
+	undefined.SetDisplay("#Status_Missing")
+	if err := l.Validate(&undefined); !errors.Is(err, steamworks.ErrInvalidRichPresence) {
+		t.Errorf("got: %v, want: ErrInvalidRichPresence", err)
+	}
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	serialized   atomic.Bool
+	theExecutor  *executor
+	executorOnce sync.Once
+)
+
+// EnableSerializedCalls makes all the calls to the Steam API run one by one on a dedicated OS thread.
+//
+// By default, the methods of the interfaces like ISteamUserStats call the Steam API on the calling goroutine's thread,
+// and calling them from multiple goroutines at the same time is not safe.
+// In the serialized mode, they can be called from any goroutine, e.g., an autosave goroutine,
+// at the cost of a thread switch for each call.
+// The calls requested by multiple goroutines at the same time are run in one batch without switching threads between them.
+//
+// The handlers registered by OnCallback are still called on the goroutine calling RunCallbacks.
+//
+// EnableSerializedCalls should be called before Load or Init. The serialized mode cannot be disabled.
+func EnableSerializedCalls() {
+	executorOnce.Do(func() {
+		theExecutor = newExecutor()
+	})
+	serialized.Store(true)
+}
+
+// executor runs functions on a dedicated OS thread.
+type executor struct {
+	queue  []func()
+	queueM sync.Mutex
+
+	// wakeup has a value when queue might have functions to run.
+	wakeup chan struct{}
+}
+
+func newExecutor() *executor {
+	e := &executor{
+		wakeup: make(chan struct{}, 1),
+	}
+	go e.loop()
+	return e
+}
+
+func (e *executor) loop() {
+	runtime.LockOSThread()
+
+	for range e.wakeup {
+		// Run all the queued functions in one batch.
+		e.queueM.Lock()
+		fs := e.queue
+		e.queue = nil
+		e.queueM.Unlock()
+
+		for _, f := range fs {
+			f()
+		}
+	}
+}
+
+func (e *executor) post(f func()) {
+	e.queueM.Lock()
+	e.queue = append(e.queue, f)
+	e.queueM.Unlock()
+
+	select {
+	case e.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+// serialize calls f and returns its result.
+// In the serialized mode, f is called on the executor's thread.
+//
+// Every call to the Steam API must be wrapped by serialize or serializeDo.
+// f must not call serialize or serializeDo, or f deadlocks.
+//
+// f must allocate the buffers passed to the Steam API as uintptr by itself.
+// A buffer on the caller's stack might be moved while the caller waits for f.
+func serialize[T any](f func() T) T {
+	if !serialized.Load() {
+		return f()
+	}
+
+	var v T
+	var p any
+	done := make(chan struct{})
+	theExecutor.post(func() {
+		defer close(done)
+		defer func() {
+			p = recover()
+		}()
+		v = f()
+	})
+	<-done
+
+	if p != nil {
+		// Re-panic with the original value so that a caller recovering it can inspect it, e.g., by errors.As.
+		panic(p)
+	}
+	return v
+}
+
+// serializeDo is like serialize but for f without a result.
+func serializeDo(f func()) {
+	serialize(func() struct{} {
+		f()
+		return struct{}{}
+	})
+}
@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// enableSerializedCalls enables the serialized mode during the test.
+// The mode is disabled after the test, so that the other tests still cover the direct calls.
+func enableSerializedCalls(t *testing.T) {
+	EnableSerializedCalls()
+	t.Cleanup(func() {
+		serialized.Store(false)
+	})
+}
+
+func TestSerializeConcurrent(t *testing.T) {
+	enableSerializedCalls(t)
+
+	// counter is not protected by a lock. The race detector reports a race unless the calls are serialized.
+	var counter int
+	const n = 100
+
+	var wg sync.WaitGroup
+	for range n {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			serializeDo(func() {
+				counter++
+			})
+		}()
+	}
+	wg.Wait()
+
+	if got := serialize(func() int { return counter }); got != n {
+		t.Errorf("got: %d, want: %d", got, n)
+	}
+}
+
+func TestSerializeOrder(t *testing.T) {
+	enableSerializedCalls(t)
+
+	// The calls from one goroutine run in the called order.
+	var got []int
+	for i := range 10 {
+		serializeDo(func() {
+			got = append(got, i)
+		})
+	}
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("got: %v", got)
+		}
+	}
+
+	// The functions posted to the executor run in the posted order.
+	var posted []int
+	var wg sync.WaitGroup
+	wg.Add(10)
+	for i := range 10 {
+		theExecutor.post(func() {
+			defer wg.Done()
+			posted = append(posted, i)
+		})
+	}
+	wg.Wait()
+	for i, v := range posted {
+		if v != i {
+			t.Fatalf("got: %v", posted)
+		}
+	}
+}
+
+func TestSerializeResult(t *testing.T) {
+	enableSerializedCalls(t)
+
+	if got, want := serialize(func() string { return "foo" }), "foo"; got != want {
+		t.Errorf("got: %q, want: %q", got, want)
+	}
+}
+
+func TestSerializeDirect(t *testing.T) {
+	// Without the serialized mode, f is called directly.
+	var called bool
+	serializeDo(func() {
+		called = true
+	})
+	if !called {
+		t.Error("f must be called before serializeDo returns")
+	}
+	if serialized.Load() {
+		t.Error("the serialized mode must be disabled after the other tests")
+	}
+}
+
+func TestSerializePanic(t *testing.T) {
+	enableSerializedCalls(t)
+
+	errFoo := errors.New("foo")
+	recovered := func(f func()) (r any) {
+		defer func() {
+			r = recover()
+		}()
+		f()
+		return nil
+	}
+
+	r := recovered(func() {
+		serializeDo(func() {
+			panic(errFoo)
+		})
+	})
+	if err, ok := r.(error); !ok || !errors.Is(err, errFoo) {
+		t.Errorf("got: %v, want: %v", r, errFoo)
+	}
+
+	r = recovered(func() {
+		serializeDo(func() {
+			var s []int
+			_ = s[len(s)]
+		})
+	})
+	var runtimeErr runtime.Error
+	if err, ok := r.(error); !ok || !errors.As(err, &runtimeErr) {
+		t.Errorf("got: %v (%T), want: a runtime.Error", r, r)
+	}
+
+	// The executor keeps working after a panic.
+	if got, want := serialize(func() int { return 1 }), 1; got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+}
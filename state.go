@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"fmt"
+	"sync/atomic"
+)
+
+// State is the lifecycle state of the Steam API.
+type State int
+
+const (
+	// StateNotLoaded indicates that the library is not loaded yet.
+	StateNotLoaded State = iota
+
+	// StateLoaded indicates that the library is loaded but the Steam API is not initialized.
+	StateLoaded
+
+	// StateInitialized indicates that the Steam API is initialized by Init.
+	StateInitialized
+
+	// StateShutDown indicates that the Steam API is shut down by Shutdown.
+	// Init can initialize the Steam API again.
+	StateShutDown
+)
+
+func (s State) String() string {
+	switch s {
+	case StateNotLoaded:
+		return "NotLoaded"
+	case StateLoaded:
+		return "Loaded"
+	case StateInitialized:
+		return "Initialized"
+	case StateShutDown:
+		return "ShutDown"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+var theState atomic.Int32
+
+// CurrentState returns the current lifecycle state of the Steam API.
+//
+// The accessors like SteamApps return the no-op implementations unless the state is StateInitialized.
+func CurrentState() State {
+	return State(theState.Load())
+}
@@ -0,0 +1,323 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The layout of CSteamID from the least significant bit:
+//
+//	32 bits: account ID
+//	20 bits: account instance
+//	 4 bits: account type
+//	 8 bits: universe
+const (
+	steamIDInstanceShift    = 32
+	steamIDAccountTypeShift = 52
+	steamIDUniverseShift    = 56
+
+	k_unSteamAccountInstanceMask = 0x000fffff
+)
+
+const (
+	// k_unSteamUserDesktopInstance is the instance of an individual account on the desktop client.
+	k_unSteamUserDesktopInstance = 1
+
+	// k_unSteamUserWebInstance is the maximum instance of an individual account.
+	k_unSteamUserWebInstance = 4
+)
+
+// The instance flags of a chat account.
+const (
+	k_EChatInstanceFlagClan  = (k_unSteamAccountInstanceMask + 1) >> 1
+	k_EChatInstanceFlagLobby = (k_unSteamAccountInstanceMask + 1) >> 2
+)
+
+// NewCSteamID returns a CSteamID from the components.
+func NewCSteamID(universe EUniverse, accountType EAccountType, instance uint32, accountID uint32) CSteamID {
+	return CSteamID(uint64(uint8(universe))<<steamIDUniverseShift |
+		uint64(uint8(accountType)&0xf)<<steamIDAccountTypeShift |
+		uint64(instance&k_unSteamAccountInstanceMask)<<steamIDInstanceShift |
+		uint64(accountID))
+}
+
+// NewIndividualCSteamID returns a CSteamID of the individual account in the public universe.
+func NewIndividualCSteamID(accountID uint32) CSteamID {
+	return NewCSteamID(EUniverse_Public, EAccountType_Individual, k_unSteamUserDesktopInstance, accountID)
+}
+
+// AccountID returns the account ID, which is unique in the account type and the universe.
+func (c CSteamID) AccountID() uint32 {
+	return uint32(c)
+}
+
+// Instance returns the account instance.
+func (c CSteamID) Instance() uint32 {
+	return uint32(c>>steamIDInstanceShift) & k_unSteamAccountInstanceMask
+}
+
+// AccountType returns the account type.
+func (c CSteamID) AccountType() EAccountType {
+	return EAccountType((c >> steamIDAccountTypeShift) & 0xf)
+}
+
+// Universe returns the universe.
+func (c CSteamID) Universe() EUniverse {
+	return EUniverse(c >> steamIDUniverseShift)
+}
+
+// IsValid reports whether c is a valid Steam ID.
+func (c CSteamID) IsValid() bool {
+	if c.AccountType() <= EAccountType_Invalid || c.AccountType() >= EAccountType_Max {
+		return false
+	}
+	if c.Universe() <= EUniverse_Invalid || c.Universe() >= EUniverse_Max {
+		return false
+	}
+	switch c.AccountType() {
+	case EAccountType_Individual:
+		if c.AccountID() == 0 || c.Instance() > k_unSteamUserWebInstance {
+			return false
+		}
+	case EAccountType_Clan:
+		if c.AccountID() == 0 || c.Instance() != 0 {
+			return false
+		}
+	case EAccountType_GameServer:
+		if c.AccountID() == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsIndividual reports whether c is an individual user account.
+func (c CSteamID) IsIndividual() bool {
+	return c.AccountType() == EAccountType_Individual || c.AccountType() == EAccountType_ConsoleUser
+}
+
+// IsLobby reports whether c is a lobby.
+func (c CSteamID) IsLobby() bool {
+	return c.AccountType() == EAccountType_Chat && c.Instance()&k_EChatInstanceFlagLobby != 0
+}
+
+// IsGameServer reports whether c is a persistent or anonymous game server account.
+func (c CSteamID) IsGameServer() bool {
+	return c.AccountType() == EAccountType_GameServer || c.AccountType() == EAccountType_AnonGameServer
+}
+
+// String returns the 64-bit decimal form of c, e.g., "76561197960287930".
+func (c CSteamID) String() string {
+	return strconv.FormatUint(uint64(c), 10)
+}
+
+// Steam2 returns the Steam2 form of c, e.g., "STEAM_1:0:11101".
+//
+// The Steam2 form is meaningful only for individual accounts.
+func (c CSteamID) Steam2() string {
+	return fmt.Sprintf("STEAM_%d:%d:%d", c.Universe(), c.AccountID()&1, c.AccountID()>>1)
+}
+
+// Steam3 returns the Steam3 form of c, e.g., "[U:1:22202]".
+//
+// The Steam3 form has no letter for EAccountType_ConsoleUser and unknown account types.
+// For them, Steam3 returns the 64-bit decimal form so that ParseCSteamID can parse the result back.
+func (c CSteamID) Steam3() string {
+	var letter byte
+	// instance is the instance that ParseCSteamID assumes for the letter.
+	var instance uint32
+	switch c.AccountType() {
+	case EAccountType_Invalid:
+		letter = 'I'
+	case EAccountType_Individual:
+		letter = 'U'
+		instance = k_unSteamUserDesktopInstance
+	case EAccountType_Multiseat:
+		letter = 'M'
+	case EAccountType_GameServer:
+		letter = 'G'
+	case EAccountType_AnonGameServer:
+		letter = 'A'
+	case EAccountType_Pending:
+		letter = 'P'
+	case EAccountType_ContentServer:
+		letter = 'C'
+	case EAccountType_Clan:
+		letter = 'g'
+	case EAccountType_Chat:
+		switch {
+		case c.Instance()&k_EChatInstanceFlagClan != 0:
+			letter = 'c'
+			instance = k_EChatInstanceFlagClan
+		case c.Instance()&k_EChatInstanceFlagLobby != 0:
+			letter = 'L'
+			instance = k_EChatInstanceFlagLobby
+		default:
+			letter = 'T'
+		}
+	case EAccountType_AnonUser:
+		letter = 'a'
+	default:
+		return c.String()
+	}
+
+	// The instance is omitted unless it differs from the one implied by the letter.
+	// AnonGameServer and Multiseat IDs always have the instance by convention.
+	if c.Instance() != instance || c.AccountType() == EAccountType_AnonGameServer || c.AccountType() == EAccountType_Multiseat {
+		return fmt.Sprintf("[%c:%d:%d:%d]", letter, c.Universe(), c.AccountID(), c.Instance())
+	}
+	return fmt.Sprintf("[%c:%d:%d]", letter, c.Universe(), c.AccountID())
+}
+
+// ParseCSteamID parses a Steam ID in the 64-bit decimal form, the Steam2 form, or the Steam3 form.
+func ParseCSteamID(s string) (CSteamID, error) {
+	switch {
+	case strings.HasPrefix(s, "STEAM_"):
+		if c, ok := parseSteam2(s); ok {
+			return c, nil
+		}
+	case strings.HasPrefix(s, "["):
+		if c, ok := parseSteam3(s); ok {
+			return c, nil
+		}
+	default:
+		if v, err := strconv.ParseUint(s, 10, 64); err == nil {
+			return CSteamID(v), nil
+		}
+	}
+	return 0, fmt.Errorf("steamworks: invalid Steam ID: %q", s)
+}
+
+func parseSteam2(s string) (CSteamID, bool) {
+	parts := strings.Split(strings.TrimPrefix(s, "STEAM_"), ":")
+	if len(parts) != 3 {
+		return 0, false
+	}
+	universe, err := strconv.ParseUint(parts[0], 10, 8)
+	if err != nil {
+		return 0, false
+	}
+	y, err := strconv.ParseUint(parts[1], 10, 1)
+	if err != nil {
+		return 0, false
+	}
+	z, err := strconv.ParseUint(parts[2], 10, 31)
+	if err != nil {
+		return 0, false
+	}
+	// Old games use 0 for the public universe.
+	if universe == uint64(EUniverse_Invalid) {
+		universe = uint64(EUniverse_Public)
+	}
+	return NewCSteamID(EUniverse(universe), EAccountType_Individual, k_unSteamUserDesktopInstance, uint32(z<<1|y)), true
+}
+
+func parseSteam3(s string) (CSteamID, bool) {
+	if !strings.HasSuffix(s, "]") {
+		return 0, false
+	}
+	parts := strings.Split(s[1:len(s)-1], ":")
+	if len(parts) != 3 && len(parts) != 4 {
+		return 0, false
+	}
+	if len(parts[0]) != 1 {
+		return 0, false
+	}
+
+	var accountType EAccountType
+	var instance uint32
+	switch parts[0][0] {
+	case 'I':
+		accountType = EAccountType_Invalid
+	case 'U':
+		accountType = EAccountType_Individual
+		instance = k_unSteamUserDesktopInstance
+	case 'M':
+		accountType = EAccountType_Multiseat
+	case 'G':
+		accountType = EAccountType_GameServer
+	case 'A':
+		accountType = EAccountType_AnonGameServer
+	case 'P':
+		accountType = EAccountType_Pending
+	case 'C':
+		accountType = EAccountType_ContentServer
+	case 'g':
+		accountType = EAccountType_Clan
+	case 'T':
+		accountType = EAccountType_Chat
+	case 'c':
+		accountType = EAccountType_Chat
+		instance = k_EChatInstanceFlagClan
+	case 'L':
+		accountType = EAccountType_Chat
+		instance = k_EChatInstanceFlagLobby
+	case 'a':
+		accountType = EAccountType_AnonUser
+	default:
+		return 0, false
+	}
+
+	universe, err := strconv.ParseUint(parts[1], 10, 8)
+	if err != nil {
+		return 0, false
+	}
+	accountID, err := strconv.ParseUint(parts[2], 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	if len(parts) == 4 {
+		v, err := strconv.ParseUint(parts[3], 10, 20)
+		if err != nil {
+			return 0, false
+		}
+		instance = uint32(v)
+	}
+	return NewCSteamID(EUniverse(universe), accountType, instance, uint32(accountID)), true
+}
+
+// MarshalJSON implements json.Marshaler. c is encoded as a JSON number in the 64-bit decimal form.
+func (c CSteamID) MarshalJSON() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(c), 10), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Both a JSON number and a JSON string in any form that ParseCSteamID accepts are accepted.
+func (c *CSteamID) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return c.UnmarshalText([]byte(s))
+	}
+	v, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("steamworks: invalid Steam ID: %s", data)
+	}
+	*c = CSteamID(v)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The text is the 64-bit decimal form.
+func (c CSteamID) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(c), 10), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. The text can be in any form that ParseCSteamID accepts.
+func (c *CSteamID) UnmarshalText(text []byte) error {
+	v, err := ParseCSteamID(string(text))
+	if err != nil {
+		return err
+	}
+	*c = v
+	return nil
+}
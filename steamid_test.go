@@ -0,0 +1,152 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+var testSteamIDs = []steamworks.CSteamID{
+	steamworks.NewIndividualCSteamID(22202),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_Individual, 0, 22202),
+	steamworks.NewCSteamID(steamworks.EUniverse_Beta, steamworks.EAccountType_Individual, 4, 22203),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_Multiseat, 2, 5),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_GameServer, 0, 1234),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_AnonGameServer, 77, 1234),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_Pending, 0, 1),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_ContentServer, 0, 1),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_Clan, 0, 103582791),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_Chat, 0, 10),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_Chat, 0x80000, 11),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_Chat, 0x40000, 12),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_Chat, 0x40003, 13),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_ConsoleUser, 0, 14),
+	steamworks.NewCSteamID(steamworks.EUniverse_Public, steamworks.EAccountType_AnonUser, 0, 15),
+	steamworks.NewCSteamID(steamworks.EUniverse_Invalid, steamworks.EAccountType_Invalid, 0, 0),
+}
+
+func TestCSteamIDFormats(t *testing.T) {
+	id := steamworks.CSteamID(76561197960287930)
+	if got, want := id.String(), "76561197960287930"; got != want {
+		t.Errorf("String: got: %q, want: %q", got, want)
+	}
+	if got, want := id.Steam2(), "STEAM_1:0:11101"; got != want {
+		t.Errorf("Steam2: got: %q, want: %q", got, want)
+	}
+	if got, want := id.Steam3(), "[U:1:22202]"; got != want {
+		t.Errorf("Steam3: got: %q, want: %q", got, want)
+	}
+}
+
+func TestCSteamIDRoundTrip(t *testing.T) {
+	for _, id := range testSteamIDs {
+		for _, s := range []string{id.String(), id.Steam3()} {
+			got, err := steamworks.ParseCSteamID(s)
+			if err != nil {
+				t.Errorf("ParseCSteamID(%q) failed: %v", s, err)
+				continue
+			}
+			if got != id {
+				t.Errorf("ParseCSteamID(%q): got: %d, want: %d", s, got, id)
+			}
+		}
+	}
+}
+
+func TestCSteamIDSteam2RoundTrip(t *testing.T) {
+	for _, accountID := range []uint32{1, 22202, 22203, 1<<32 - 1} {
+		id := steamworks.NewIndividualCSteamID(accountID)
+		got, err := steamworks.ParseCSteamID(id.Steam2())
+		if err != nil {
+			t.Errorf("ParseCSteamID(%q) failed: %v", id.Steam2(), err)
+			continue
+		}
+		if got != id {
+			t.Errorf("ParseCSteamID(%q): got: %d, want: %d", id.Steam2(), got, id)
+		}
+	}
+
+	// Old games use 0 for the public universe.
+	got, err := steamworks.ParseCSteamID("STEAM_0:0:11101")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := steamworks.CSteamID(76561197960287930); got != want {
+		t.Errorf("got: %d, want: %d", got, want)
+	}
+}
+
+func TestParseCSteamIDInvalid(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"abc",
+		"-1",
+		"STEAM_1:2:3",
+		"STEAM_1:0",
+		"[U:1]",
+		"[X:1:2]",
+		"[U:1:2",
+		"[U:1:2:3:4]",
+	} {
+		if _, err := steamworks.ParseCSteamID(s); err == nil {
+			t.Errorf("ParseCSteamID(%q) must fail", s)
+		}
+	}
+}
+
+func TestCSteamIDJSON(t *testing.T) {
+	type user struct {
+		ID steamworks.CSteamID `json:"id"`
+	}
+
+	b, err := json.Marshal(user{ID: 76561197960287930})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"id":76561197960287930}`; got != want {
+		t.Errorf("Marshal: got: %s, want: %s", got, want)
+	}
+
+	for _, s := range []string{
+		`{"id":76561197960287930}`,
+		`{"id":"76561197960287930"}`,
+		`{"id":"STEAM_1:0:11101"}`,
+		`{"id":"[U:1:22202]"}`,
+	} {
+		var u user
+		if err := json.Unmarshal([]byte(s), &u); err != nil {
+			t.Errorf("Unmarshal(%s) failed: %v", s, err)
+			continue
+		}
+		if got, want := u.ID, steamworks.CSteamID(76561197960287930); got != want {
+			t.Errorf("Unmarshal(%s): got: %d, want: %d", s, got, want)
+		}
+	}
+
+	for _, s := range []string{`{"id":-1}`, `{"id":1.5}`, `{"id":"x"}`, `{"id":true}`} {
+		var u user
+		if err := json.Unmarshal([]byte(s), &u); err == nil {
+			t.Errorf("Unmarshal(%s) must fail", s)
+		}
+	}
+
+	// A map key is encoded as a string.
+	b, err = json.Marshal(map[steamworks.CSteamID]int{76561197960287930: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(b), `{"76561197960287930":1}`; got != want {
+		t.Errorf("Marshal map: got: %s, want: %s", got, want)
+	}
+	var m map[steamworks.CSteamID]int
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatal(err)
+	}
+	if m[76561197960287930] != 1 {
+		t.Errorf("Unmarshal map: got: %v", m)
+	}
+}
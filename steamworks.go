@@ -5,9 +5,193 @@
 
 package steamworks
 
+import (
+	"context"
+	"fmt"
+	"image"
+	"iter"
+	"time"
+)
+
 type AppId_t uint32
 type CSteamID uint64
+type HSteamPipe int32
+type HSteamUser int32
 type InputHandle_t uint64
+type SteamAPICall_t uint64
+
+const (
+	k_uAPICallInvalid SteamAPICall_t = 0
+)
+
+type EResult int32
+
+const (
+	EResult_None                          EResult = 0
+	EResult_OK                            EResult = 1
+	EResult_Fail                          EResult = 2
+	EResult_NoConnection                  EResult = 3
+	EResult_InvalidPassword               EResult = 5
+	EResult_LoggedInElsewhere             EResult = 6
+	EResult_InvalidProtocolVer            EResult = 7
+	EResult_InvalidParam                  EResult = 8
+	EResult_FileNotFound                  EResult = 9
+	EResult_Busy                          EResult = 10
+	EResult_InvalidState                  EResult = 11
+	EResult_InvalidName                   EResult = 12
+	EResult_InvalidEmail                  EResult = 13
+	EResult_DuplicateName                 EResult = 14
+	EResult_AccessDenied                  EResult = 15
+	EResult_Timeout                       EResult = 16
+	EResult_Banned                        EResult = 17
+	EResult_AccountNotFound               EResult = 18
+	EResult_InvalidSteamID                EResult = 19
+	EResult_ServiceUnavailable            EResult = 20
+	EResult_NotLoggedOn                   EResult = 21
+	EResult_Pending                       EResult = 22
+	EResult_EncryptionFailure             EResult = 23
+	EResult_InsufficientPrivilege         EResult = 24
+	EResult_LimitExceeded                 EResult = 25
+	EResult_Revoked                       EResult = 26
+	EResult_Expired                       EResult = 27
+	EResult_AlreadyRedeemed               EResult = 28
+	EResult_DuplicateRequest              EResult = 29
+	EResult_AlreadyOwned                  EResult = 30
+	EResult_IPNotFound                    EResult = 31
+	EResult_PersistFailed                 EResult = 32
+	EResult_LockingFailed                 EResult = 33
+	EResult_LogonSessionReplaced          EResult = 34
+	EResult_ConnectFailed                 EResult = 35
+	EResult_HandshakeFailed               EResult = 36
+	EResult_IOFailure                     EResult = 37
+	EResult_RemoteDisconnect              EResult = 38
+	EResult_ShoppingCartNotFound          EResult = 39
+	EResult_Blocked                       EResult = 40
+	EResult_Ignored                       EResult = 41
+	EResult_NoMatch                       EResult = 42
+	EResult_AccountDisabled               EResult = 43
+	EResult_ServiceReadOnly               EResult = 44
+	EResult_AccountNotFeatured            EResult = 45
+	EResult_AdministratorOK               EResult = 46
+	EResult_ContentVersion                EResult = 47
+	EResult_TryAnotherCM                  EResult = 48
+	EResult_PasswordRequiredToKickSession EResult = 49
+	EResult_AlreadyLoggedInElsewhere      EResult = 50
+	EResult_Suspended                     EResult = 51
+	EResult_Cancelled                     EResult = 52
+	EResult_DataCorruption                EResult = 53
+	EResult_DiskFull                      EResult = 54
+	EResult_RemoteCallFailed              EResult = 55
+	EResult_RateLimitExceeded             EResult = 84
+	EResult_RemoteFileConflict            EResult = 99
+	EResult_CloudQuotaExceeded            EResult = 126 // Unused
+)
+
+type EUniverse int32
+
+const (
+	EUniverse_Invalid  EUniverse = 0
+	EUniverse_Public   EUniverse = 1
+	EUniverse_Beta     EUniverse = 2
+	EUniverse_Internal EUniverse = 3
+	EUniverse_Dev      EUniverse = 4
+	EUniverse_Max      EUniverse = 5
+)
+
+type EAccountType int32
+
+const (
+	EAccountType_Invalid        EAccountType = 0
+	EAccountType_Individual     EAccountType = 1
+	EAccountType_Multiseat      EAccountType = 2
+	EAccountType_GameServer     EAccountType = 3
+	EAccountType_AnonGameServer EAccountType = 4
+	EAccountType_Pending        EAccountType = 5
+	EAccountType_ContentServer  EAccountType = 6
+	EAccountType_Clan           EAccountType = 7
+	EAccountType_Chat           EAccountType = 8
+	EAccountType_ConsoleUser    EAccountType = 9
+	EAccountType_AnonUser       EAccountType = 10
+	EAccountType_Max            EAccountType = 11
+)
+
+type EBetaBranchFlags int32
+
+const (
+	EBetaBranchFlags_None      EBetaBranchFlags = 0
+	EBetaBranchFlags_Default   EBetaBranchFlags = 1
+	EBetaBranchFlags_Available EBetaBranchFlags = 2
+	EBetaBranchFlags_Private   EBetaBranchFlags = 4
+	EBetaBranchFlags_Selected  EBetaBranchFlags = 8
+	EBetaBranchFlags_Installed EBetaBranchFlags = 16
+)
+
+type EActivateGameOverlayToWebPageMode int32
+
+const (
+	EActivateGameOverlayToWebPageMode_Default EActivateGameOverlayToWebPageMode = 0
+	EActivateGameOverlayToWebPageMode_Modal   EActivateGameOverlayToWebPageMode = 1
+)
+
+type EFriendFlags int32
+
+const (
+	EFriendFlags_None                 EFriendFlags = 0x00
+	EFriendFlags_Blocked              EFriendFlags = 0x01
+	EFriendFlags_FriendshipRequested  EFriendFlags = 0x02
+	EFriendFlags_Immediate            EFriendFlags = 0x04
+	EFriendFlags_ClanMember           EFriendFlags = 0x08
+	EFriendFlags_OnGameServer         EFriendFlags = 0x10
+	EFriendFlags_RequestingFriendship EFriendFlags = 0x80
+	EFriendFlags_RequestingInfo       EFriendFlags = 0x100
+	EFriendFlags_Ignored              EFriendFlags = 0x200
+	EFriendFlags_IgnoredFriend        EFriendFlags = 0x400
+	EFriendFlags_ChatMember           EFriendFlags = 0x1000
+	EFriendFlags_All                  EFriendFlags = 0xFFFF
+)
+
+type EFriendRelationship int32
+
+const (
+	EFriendRelationship_None             EFriendRelationship = 0
+	EFriendRelationship_Blocked          EFriendRelationship = 1
+	EFriendRelationship_RequestRecipient EFriendRelationship = 2
+	EFriendRelationship_Friend           EFriendRelationship = 3
+	EFriendRelationship_RequestInitiator EFriendRelationship = 4
+	EFriendRelationship_Ignored          EFriendRelationship = 5
+	EFriendRelationship_IgnoredFriend    EFriendRelationship = 6
+)
+
+type ENotificationPosition int32
+
+const (
+	ENotificationPosition_Invalid     ENotificationPosition = -1
+	ENotificationPosition_TopLeft     ENotificationPosition = 0
+	ENotificationPosition_TopRight    ENotificationPosition = 1
+	ENotificationPosition_BottomLeft  ENotificationPosition = 2
+	ENotificationPosition_BottomRight ENotificationPosition = 3
+)
+
+type EOverlayToStoreFlag int32
+
+const (
+	EOverlayToStoreFlag_None             EOverlayToStoreFlag = 0
+	EOverlayToStoreFlag_AddToCart        EOverlayToStoreFlag = 1
+	EOverlayToStoreFlag_AddToCartAndShow EOverlayToStoreFlag = 2
+)
+
+type EPersonaState int32
+
+const (
+	EPersonaState_Offline        EPersonaState = 0
+	EPersonaState_Online         EPersonaState = 1
+	EPersonaState_Busy           EPersonaState = 2
+	EPersonaState_Away           EPersonaState = 3
+	EPersonaState_Snooze         EPersonaState = 4
+	EPersonaState_LookingToTrade EPersonaState = 5
+	EPersonaState_LookingToPlay  EPersonaState = 6
+	EPersonaState_Invisible      EPersonaState = 7
+)
 
 type ESteamAPIInitResult int32
 
@@ -18,6 +202,20 @@ const (
 	ESteamAPIInitResult_VersionMismatch ESteamAPIInitResult = 3
 )
 
+func (e ESteamAPIInitResult) String() string {
+	switch e {
+	case ESteamAPIInitResult_OK:
+		return "OK"
+	case ESteamAPIInitResult_FailedGeneric:
+		return "FailedGeneric"
+	case ESteamAPIInitResult_NoSteamClient:
+		return "NoSteamClient"
+	case ESteamAPIInitResult_VersionMismatch:
+		return "VersionMismatch"
+	}
+	return fmt.Sprintf("ESteamAPIInitResult(%d)", int32(e))
+}
+
 type ESteamInputType int32
 
 const (
@@ -44,6 +242,19 @@ const (
 	_STEAM_INPUT_MAX_COUNT = 16
 )
 
+const (
+	k_cchStatNameMax = 128
+)
+
+const (
+	k_iSteamUserCallbacks          = 100
+	k_iSteamFriendsCallbacks       = 300
+	k_iSteamUtilsCallbacks         = 700
+	k_iSteamAppsCallbacks          = 1000
+	k_iSteamUserStatsCallbacks     = 1100
+	k_iSteamRemoteStorageCallbacks = 1300
+)
+
 type EFloatingGamepadTextInputMode int32
 
 const (
@@ -55,10 +266,33 @@ const (
 
 type ISteamApps interface {
 	BGetDLCDataByIndex(iDLC int) (appID AppId_t, available bool, pchName string, success bool)
+	BIsAppInstalled(appID AppId_t) bool
+	BIsCybercafe() bool
 	BIsDlcInstalled(appID AppId_t) bool
+	BIsLowViolence() bool
+	BIsSubscribed() bool
+	BIsSubscribedApp(appID AppId_t) bool
+	BIsSubscribedFromFamilySharing() bool
+	BIsSubscribedFromFreeWeekend() bool
+	BIsTimedTrial() (allowed, played time.Duration, ok bool)
+	BIsVACBanned() bool
+	DLCs() iter.Seq[DLC]
+	GetAppBuildId() int32
 	GetAppInstallDir(appID AppId_t) string
+	GetAppOwner() CSteamID
+	GetAvailableGameLanguages() []string
+	GetBetaInfo(index int32) (beta Beta, ok bool)
+	GetCurrentBetaName() (name string, ok bool)
 	GetCurrentGameLanguage() string
 	GetDLCCount() int32
+	GetDlcDownloadProgress(appID AppId_t) (bytesDownloaded, bytesTotal uint64, ok bool)
+	GetEarliestPurchaseUnixTime(appID AppId_t) time.Time
+	GetLaunchCommandLine() string
+	GetLaunchQueryParam(key string) string
+	GetNumBetas() (total, available, private int32)
+	InstallDLC(appID AppId_t)
+	SetActiveBeta(name string) bool
+	UninstallDLC(appID AppId_t)
 }
 
 type ISteamInput interface {
@@ -70,6 +304,7 @@ type ISteamInput interface {
 
 type ISteamRemoteStorage interface {
 	FileWrite(file string, data []byte) bool
+	FileWriteAsync(file string, data []byte) *CallResult[RemoteStorageFileWriteAsyncComplete]
 	FileRead(file string, data []byte) int32
 	FileDelete(file string) bool
 	GetFileSize(file string) int32
@@ -87,31 +322,122 @@ type ISteamUserStats interface {
 }
 
 type ISteamUtils interface {
+	BOverlayNeedsPresent() bool
+	GetImageRGBA(handle int32) ([]byte, bool)
+	GetImageSize(handle int32) (width, height uint32, ok bool)
 	IsOverlayEnabled() bool
 	IsSteamRunningOnSteamDeck() bool
+	SetOverlayNotificationInset(horizontalInset, verticalInset int32)
+	SetOverlayNotificationPosition(notificationPosition ENotificationPosition)
 	ShowFloatingGamepadTextInput(keyboardMode EFloatingGamepadTextInputMode, textFieldXPosition, textFieldYPosition, textFieldWidth, textFieldHeight int32) bool
 }
 
 type ISteamFriends interface {
+	ActivateGameOverlay(dialog GameOverlayDialog)
+	ActivateGameOverlayInviteDialog(lobby CSteamID)
+	ActivateGameOverlayInviteDialogConnectString(connectString string)
+	ActivateGameOverlayToStore(appID AppId_t, flag EOverlayToStoreFlag)
+	ActivateGameOverlayToUser(dialog GameOverlayUserDialog, user CSteamID)
+	ActivateGameOverlayToWebPage(url string, mode EActivateGameOverlayToWebPageMode)
+
+	// Avatar returns the avatar image of friend.
+	//
+	// If the avatar is not loaded yet, Avatar requests it and blocks until it is delivered by RunCallbacks or ctx is done.
+	// Thus, Avatar must be called on another goroutine than the one calling RunCallbacks.
+	// Calling Avatar on the goroutine calling RunCallbacks with a context without a deadline blocks forever
+	// unless the avatar is already loaded.
+	//
+	// Avatar returns ErrNoAvatar if the user has no avatar, and an error if size is invalid.
+	Avatar(ctx context.Context, friend CSteamID, size AvatarSize) (*image.RGBA, error)
+
+	ClearRichPresence()
+	Friends(flags EFriendFlags) iter.Seq[Friend]
+	GetFriendByIndex(index int32, flags EFriendFlags) CSteamID
+	GetFriendCount(flags EFriendFlags) int32
+	GetFriendGamePlayed(friend CSteamID) (info FriendGameInfo, ok bool)
+	GetFriendPersonaName(friend CSteamID) string
+	GetFriendPersonaState(friend CSteamID) EPersonaState
+	GetFriendRelationship(friend CSteamID) EFriendRelationship
+	GetFriendRichPresence(friend CSteamID, key string) string
+	GetFriendRichPresenceKeyByIndex(friend CSteamID, index int32) string
+	GetFriendRichPresenceKeyCount(friend CSteamID) int32
+	GetFriendSteamLevel(friend CSteamID) int32
 	GetPersonaName() string
+	GetPlayerNickname(player CSteamID) string
+	RequestFriendRichPresence(friend CSteamID)
 	SetRichPresence(string, string) bool
 }
 
 const (
-	flatAPI_RestartAppIfNecessary = "SteamAPI_RestartAppIfNecessary"
-	flatAPI_InitFlat              = "SteamAPI_InitFlat"
-	flatAPI_RunCallbacks          = "SteamAPI_RunCallbacks"
+	flatAPI_RestartAppIfNecessary       = "SteamAPI_RestartAppIfNecessary"
+	flatAPI_InitFlat                    = "SteamAPI_InitFlat"
+	flatAPI_SteamInternal_SteamAPI_Init = "SteamInternal_SteamAPI_Init"
+	flatAPI_Shutdown                    = "SteamAPI_Shutdown"
+	flatAPI_IsSteamRunning              = "SteamAPI_IsSteamRunning"
+	flatAPI_ReleaseCurrentThreadMemory  = "SteamAPI_ReleaseCurrentThreadMemory"
+	flatAPI_GetHSteamPipe               = "SteamAPI_GetHSteamPipe"
 
-	flatAPI_SteamApps                         = "SteamAPI_SteamApps_v008"
-	flatAPI_ISteamApps_BGetDLCDataByIndex     = "SteamAPI_ISteamApps_BGetDLCDataByIndex"
-	flatAPI_ISteamApps_BIsDlcInstalled        = "SteamAPI_ISteamApps_BIsDlcInstalled"
-	flatAPI_ISteamApps_GetAppInstallDir       = "SteamAPI_ISteamApps_GetAppInstallDir"
-	flatAPI_ISteamApps_GetCurrentGameLanguage = "SteamAPI_ISteamApps_GetCurrentGameLanguage"
-	flatAPI_ISteamApps_GetDLCCount            = "SteamAPI_ISteamApps_GetDLCCount"
+	flatAPI_ManualDispatch_Init             = "SteamAPI_ManualDispatch_Init"
+	flatAPI_ManualDispatch_RunFrame         = "SteamAPI_ManualDispatch_RunFrame"
+	flatAPI_ManualDispatch_GetNextCallback  = "SteamAPI_ManualDispatch_GetNextCallback"
+	flatAPI_ManualDispatch_FreeLastCallback = "SteamAPI_ManualDispatch_FreeLastCallback"
+	flatAPI_ManualDispatch_GetAPICallResult = "SteamAPI_ManualDispatch_GetAPICallResult"
 
-	flatAPI_SteamFriends                  = "SteamAPI_SteamFriends_v017"
-	flatAPI_ISteamFriends_GetPersonaName  = "SteamAPI_ISteamFriends_GetPersonaName"
-	flatAPI_ISteamFriends_SetRichPresence = "SteamAPI_ISteamFriends_SetRichPresence"
+	flatAPI_SteamApps                                 = "SteamAPI_SteamApps_v008"
+	flatAPI_ISteamApps_BGetDLCDataByIndex             = "SteamAPI_ISteamApps_BGetDLCDataByIndex"
+	flatAPI_ISteamApps_BIsDlcInstalled                = "SteamAPI_ISteamApps_BIsDlcInstalled"
+	flatAPI_ISteamApps_GetAppInstallDir               = "SteamAPI_ISteamApps_GetAppInstallDir"
+	flatAPI_ISteamApps_GetCurrentGameLanguage         = "SteamAPI_ISteamApps_GetCurrentGameLanguage"
+	flatAPI_ISteamApps_GetDLCCount                    = "SteamAPI_ISteamApps_GetDLCCount"
+	flatAPI_ISteamApps_BIsSubscribed                  = "SteamAPI_ISteamApps_BIsSubscribed"
+	flatAPI_ISteamApps_BIsSubscribedApp               = "SteamAPI_ISteamApps_BIsSubscribedApp"
+	flatAPI_ISteamApps_BIsLowViolence                 = "SteamAPI_ISteamApps_BIsLowViolence"
+	flatAPI_ISteamApps_BIsCybercafe                   = "SteamAPI_ISteamApps_BIsCybercafe"
+	flatAPI_ISteamApps_BIsVACBanned                   = "SteamAPI_ISteamApps_BIsVACBanned"
+	flatAPI_ISteamApps_BIsSubscribedFromFreeWeekend   = "SteamAPI_ISteamApps_BIsSubscribedFromFreeWeekend"
+	flatAPI_ISteamApps_BIsSubscribedFromFamilySharing = "SteamAPI_ISteamApps_BIsSubscribedFromFamilySharing"
+	flatAPI_ISteamApps_GetAppOwner                    = "SteamAPI_ISteamApps_GetAppOwner"
+	flatAPI_ISteamApps_GetAppBuildId                  = "SteamAPI_ISteamApps_GetAppBuildId"
+	flatAPI_ISteamApps_GetCurrentBetaName             = "SteamAPI_ISteamApps_GetCurrentBetaName"
+	flatAPI_ISteamApps_GetAvailableGameLanguages      = "SteamAPI_ISteamApps_GetAvailableGameLanguages"
+	flatAPI_ISteamApps_GetEarliestPurchaseUnixTime    = "SteamAPI_ISteamApps_GetEarliestPurchaseUnixTime"
+	flatAPI_ISteamApps_GetLaunchQueryParam            = "SteamAPI_ISteamApps_GetLaunchQueryParam"
+	flatAPI_ISteamApps_GetLaunchCommandLine           = "SteamAPI_ISteamApps_GetLaunchCommandLine"
+	flatAPI_ISteamApps_BIsTimedTrial                  = "SteamAPI_ISteamApps_BIsTimedTrial"
+	flatAPI_ISteamApps_InstallDLC                     = "SteamAPI_ISteamApps_InstallDLC"
+	flatAPI_ISteamApps_UninstallDLC                   = "SteamAPI_ISteamApps_UninstallDLC"
+	flatAPI_ISteamApps_GetDlcDownloadProgress         = "SteamAPI_ISteamApps_GetDlcDownloadProgress"
+	flatAPI_ISteamApps_BIsAppInstalled                = "SteamAPI_ISteamApps_BIsAppInstalled"
+	flatAPI_ISteamApps_GetNumBetas                    = "SteamAPI_ISteamApps_GetNumBetas"
+	flatAPI_ISteamApps_GetBetaInfo                    = "SteamAPI_ISteamApps_GetBetaInfo"
+	flatAPI_ISteamApps_SetActiveBeta                  = "SteamAPI_ISteamApps_SetActiveBeta"
+
+	flatAPI_SteamFriends                                               = "SteamAPI_SteamFriends_v017"
+	flatAPI_ISteamFriends_GetPersonaName                               = "SteamAPI_ISteamFriends_GetPersonaName"
+	flatAPI_ISteamFriends_SetRichPresence                              = "SteamAPI_ISteamFriends_SetRichPresence"
+	flatAPI_ISteamFriends_GetFriendCount                               = "SteamAPI_ISteamFriends_GetFriendCount"
+	flatAPI_ISteamFriends_GetFriendByIndex                             = "SteamAPI_ISteamFriends_GetFriendByIndex"
+	flatAPI_ISteamFriends_GetFriendPersonaName                         = "SteamAPI_ISteamFriends_GetFriendPersonaName"
+	flatAPI_ISteamFriends_GetFriendPersonaState                        = "SteamAPI_ISteamFriends_GetFriendPersonaState"
+	flatAPI_ISteamFriends_GetFriendRelationship                        = "SteamAPI_ISteamFriends_GetFriendRelationship"
+	flatAPI_ISteamFriends_GetFriendGamePlayed                          = "SteamAPI_ISteamFriends_GetFriendGamePlayed"
+	flatAPI_ISteamFriends_GetPlayerNickname                            = "SteamAPI_ISteamFriends_GetPlayerNickname"
+	flatAPI_ISteamFriends_GetFriendSteamLevel                          = "SteamAPI_ISteamFriends_GetFriendSteamLevel"
+	flatAPI_ISteamFriends_GetSmallFriendAvatar                         = "SteamAPI_ISteamFriends_GetSmallFriendAvatar"
+	flatAPI_ISteamFriends_GetMediumFriendAvatar                        = "SteamAPI_ISteamFriends_GetMediumFriendAvatar"
+	flatAPI_ISteamFriends_GetLargeFriendAvatar                         = "SteamAPI_ISteamFriends_GetLargeFriendAvatar"
+	flatAPI_ISteamFriends_RequestUserInformation                       = "SteamAPI_ISteamFriends_RequestUserInformation"
+	flatAPI_ISteamFriends_ActivateGameOverlay                          = "SteamAPI_ISteamFriends_ActivateGameOverlay"
+	flatAPI_ISteamFriends_ActivateGameOverlayToUser                    = "SteamAPI_ISteamFriends_ActivateGameOverlayToUser"
+	flatAPI_ISteamFriends_ActivateGameOverlayToWebPage                 = "SteamAPI_ISteamFriends_ActivateGameOverlayToWebPage"
+	flatAPI_ISteamFriends_ActivateGameOverlayToStore                   = "SteamAPI_ISteamFriends_ActivateGameOverlayToStore"
+	flatAPI_ISteamFriends_ActivateGameOverlayInviteDialog              = "SteamAPI_ISteamFriends_ActivateGameOverlayInviteDialog"
+	flatAPI_ISteamFriends_ActivateGameOverlayInviteDialogConnectString = "SteamAPI_ISteamFriends_ActivateGameOverlayInviteDialogConnectString"
+	flatAPI_ISteamFriends_ClearRichPresence                            = "SteamAPI_ISteamFriends_ClearRichPresence"
+	flatAPI_ISteamFriends_GetFriendRichPresence                        = "SteamAPI_ISteamFriends_GetFriendRichPresence"
+	flatAPI_ISteamFriends_GetFriendRichPresenceKeyCount                = "SteamAPI_ISteamFriends_GetFriendRichPresenceKeyCount"
+	flatAPI_ISteamFriends_GetFriendRichPresenceKeyByIndex              = "SteamAPI_ISteamFriends_GetFriendRichPresenceKeyByIndex"
+	flatAPI_ISteamFriends_RequestFriendRichPresence                    = "SteamAPI_ISteamFriends_RequestFriendRichPresence"
 
 	flatAPI_SteamInput                          = "SteamAPI_SteamInput_v006"
 	flatAPI_ISteamInput_GetConnectedControllers = "SteamAPI_ISteamInput_GetConnectedControllers"
@@ -119,11 +445,12 @@ const (
 	flatAPI_ISteamInput_Init                    = "SteamAPI_ISteamInput_Init"
 	flatAPI_ISteamInput_RunFrame                = "SteamAPI_ISteamInput_RunFrame"
 
-	flatAPI_SteamRemoteStorage              = "SteamAPI_SteamRemoteStorage_v016"
-	flatAPI_ISteamRemoteStorage_FileWrite   = "SteamAPI_ISteamRemoteStorage_FileWrite"
-	flatAPI_ISteamRemoteStorage_FileRead    = "SteamAPI_ISteamRemoteStorage_FileRead"
-	flatAPI_ISteamRemoteStorage_FileDelete  = "SteamAPI_ISteamRemoteStorage_FileDelete"
-	flatAPI_ISteamRemoteStorage_GetFileSize = "SteamAPI_ISteamRemoteStorage_GetFileSize"
+	flatAPI_SteamRemoteStorage                 = "SteamAPI_SteamRemoteStorage_v016"
+	flatAPI_ISteamRemoteStorage_FileWrite      = "SteamAPI_ISteamRemoteStorage_FileWrite"
+	flatAPI_ISteamRemoteStorage_FileWriteAsync = "SteamAPI_ISteamRemoteStorage_FileWriteAsync"
+	flatAPI_ISteamRemoteStorage_FileRead       = "SteamAPI_ISteamRemoteStorage_FileRead"
+	flatAPI_ISteamRemoteStorage_FileDelete     = "SteamAPI_ISteamRemoteStorage_FileDelete"
+	flatAPI_ISteamRemoteStorage_GetFileSize    = "SteamAPI_ISteamRemoteStorage_GetFileSize"
 
 	flatAPI_SteamUser             = "SteamAPI_SteamUser_v023"
 	flatAPI_ISteamUser_GetSteamID = "SteamAPI_ISteamUser_GetSteamID"
@@ -134,12 +461,36 @@ const (
 	flatAPI_ISteamUserStats_ClearAchievement = "SteamAPI_ISteamUserStats_ClearAchievement"
 	flatAPI_ISteamUserStats_StoreStats       = "SteamAPI_ISteamUserStats_StoreStats"
 
-	flatAPI_SteamUtils                               = "SteamAPI_SteamUtils_v010"
-	flatAPI_ISteamUtils_IsOverlayEnabled             = "SteamAPI_ISteamUtils_IsOverlayEnabled"
-	flatAPI_ISteamUtils_IsSteamRunningOnSteamDeck    = "SteamAPI_ISteamUtils_IsSteamRunningOnSteamDeck"
-	flatAPI_ISteamUtils_ShowFloatingGamepadTextInput = "SteamAPI_ISteamUtils_ShowFloatingGamepadTextInput"
+	flatAPI_SteamUtils                                 = "SteamAPI_SteamUtils_v010"
+	flatAPI_ISteamUtils_IsOverlayEnabled               = "SteamAPI_ISteamUtils_IsOverlayEnabled"
+	flatAPI_ISteamUtils_IsSteamRunningOnSteamDeck      = "SteamAPI_ISteamUtils_IsSteamRunningOnSteamDeck"
+	flatAPI_ISteamUtils_ShowFloatingGamepadTextInput   = "SteamAPI_ISteamUtils_ShowFloatingGamepadTextInput"
+	flatAPI_ISteamUtils_GetImageSize                   = "SteamAPI_ISteamUtils_GetImageSize"
+	flatAPI_ISteamUtils_GetImageRGBA                   = "SteamAPI_ISteamUtils_GetImageRGBA"
+	flatAPI_ISteamUtils_BOverlayNeedsPresent           = "SteamAPI_ISteamUtils_BOverlayNeedsPresent"
+	flatAPI_ISteamUtils_SetOverlayNotificationPosition = "SteamAPI_ISteamUtils_SetOverlayNotificationPosition"
+	flatAPI_ISteamUtils_SetOverlayNotificationInset    = "SteamAPI_ISteamUtils_SetOverlayNotificationInset"
 )
 
+// interfaceVersions is the list of the versions of the interfaces this package calls.
+// These must match the versions of the accessors like flatAPI_SteamApps.
+var interfaceVersions = []string{
+	"STEAMAPPS_INTERFACE_VERSION008",
+	"SteamFriends017",
+	"SteamInput006",
+	"STEAMREMOTESTORAGE_INTERFACE_VERSION016",
+	"SteamUser023",
+	"STEAMUSERSTATS_INTERFACE_VERSION013",
+	"SteamUtils010",
+}
+
+type callbackMsg_t struct {
+	m_hSteamUser HSteamUser
+	m_iCallback  int32
+	m_pubParam   *byte
+	m_cubParam   int32
+}
+
 type steamErrMsg [1024]byte
 
 func (s *steamErrMsg) String() string {
@@ -1,6 +1,8 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2021 The go-steamworks Authors
 
+//go:build !nosteam && !ios
+
 package steamworks
 
 import (
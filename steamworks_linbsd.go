@@ -1,39 +1,65 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2021 The go-steamworks Authors
 
-//go:build !windows
+//go:build !nosteam && ((linux && !android) || (darwin && !ios) || (freebsd && cgo)) && (amd64 || arm64)
 
 package steamworks
 
 import (
-	_ "embed"
 	"fmt"
 	"os"
-	"path/filepath"
 	"runtime"
 
 	"github.com/ebitengine/purego"
 )
 
-func loadLib() (uintptr, error) {
-	dir, err := os.MkdirTemp("", "")
-	if err != nil {
-		return 0, err
+var libFileName = func() string {
+	if runtime.GOOS == "darwin" {
+		return "libsteam_api.dylib"
 	}
+	return "libsteam_api.so"
+}()
 
-	ext := ".so"
-	if runtime.GOOS == "darwin" {
-		ext = ".dylib"
+func defaultLoadOptions() []LoadOption {
+	if libSteamAPI == nil {
+		// No library is embedded for this platform, e.g., Linux arm64 and FreeBSD.
+		// Use the library provided by the environment, e.g., the Steam runtime.
+		return []LoadOption{
+			WithEnvOverride("STEAMWORKS_LIB"),
+			WithSearchNextToExecutable(),
+			WithSystemLibrary(),
+		}
 	}
-	path := filepath.Join(dir, "libsteam_api"+ext)
-	if err := os.WriteFile(path, libSteamAPI, 0644); err != nil {
-		return 0, err
+	return []LoadOption{WithEmbedded()}
+}
+
+// openLibFile opens the library file to verify its content.
+func openLibFile(path string) (*os.File, error) {
+	return os.Open(path)
+}
+
+// openVerifiedLib loads the library file f whose content is verified.
+// On Linux, the library is loaded through f's file descriptor, so that the file loaded is exactly the file verified.
+func openVerifiedLib(f *os.File, path string) (uintptr, error) {
+	if runtime.GOOS == "linux" {
+		return openLib(fmt.Sprintf("/proc/self/fd/%d", f.Fd()))
 	}
+	return openLib(path)
+}
 
+func openLib(path string) (uintptr, error) {
 	lib, err := purego.Dlopen(path, purego.RTLD_LAZY|purego.RTLD_LOCAL)
 	if err != nil {
-		return 0, fmt.Errorf("steamworks: dlopen failed: %w", err)
+		return 0, fmt.Errorf("dlopen failed: %w", err)
 	}
-
 	return lib, nil
 }
+
+func closeLib(lib uintptr) {
+	_ = purego.Dlclose(lib)
+}
+
+// lookupSymbol returns the address of the symbol name in the library.
+func lookupSymbol(lib uintptr, name string) (uintptr, error) {
+	return purego.Dlsym(lib, name)
+}
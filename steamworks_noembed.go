@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+//go:build !nosteam && !windows && !darwin && !(linux && amd64)
+
+package steamworks
+
+// libSteamAPI is nil as the Steamworks SDK has no redistributable library for this platform.
+// The library must be provided by WithLibraryPath or the other options of Load.
+var libSteamAPI []byte
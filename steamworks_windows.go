@@ -1,12 +1,92 @@
 // SPDX-License-Identifier: Apache-2.0
 // SPDX-FileCopyrightText: 2021 The go-steamworks Authors
 
+//go:build !nosteam && (amd64 || arm64)
+
 package steamworks
 
-import "syscall"
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+	"unsafe"
+)
+
+const libFileName = "steam_api64.dll"
+
+func defaultLoadOptions() []LoadOption {
+	if libSteamAPI != nil {
+		return []LoadOption{WithEmbedded()}
+	}
+	return []LoadOption{WithSystemLibrary()}
+}
+
+const (
+	_LOAD_LIBRARY_SEARCH_DLL_LOAD_DIR    = 0x00000100
+	_LOAD_LIBRARY_SEARCH_APPLICATION_DIR = 0x00000200
+	_LOAD_LIBRARY_SEARCH_SYSTEM32        = 0x00000800
+)
+
+// openLibFile opens the library file to verify its content.
+// The file is shared only for reading, so that the file cannot be written, renamed or removed while it is open.
+func openLibFile(path string) (*os.File, error) {
+	p, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return nil, err
+	}
+	h, err := syscall.CreateFile(p, syscall.GENERIC_READ, syscall.FILE_SHARE_READ, nil, syscall.OPEN_EXISTING, syscall.FILE_ATTRIBUTE_NORMAL, 0)
+	if err != nil {
+		return nil, &os.PathError{Op: "open", Path: path, Err: err}
+	}
+	return os.NewFile(uintptr(h), path), nil
+}
+
+// openVerifiedLib loads the library file f whose content is verified.
+// f is kept open while the library is loaded, so the file cannot be replaced in the meantime.
+func openVerifiedLib(f *os.File, path string) (uintptr, error) {
+	return openLib(path)
+}
+
+var procLoadLibraryExW = syscall.NewLazyDLL("kernel32.dll").NewProc("LoadLibraryExW")
+
+// openLib loads the library at path.
+//
+// The current directory and PATH are never searched, so that a DLL planted there is never loaded.
+// A file name without a directory is searched only in the executable's directory and System32.
+// Another relative path is resolved against the executable's directory.
+func openLib(path string) (uintptr, error) {
+	if !filepath.IsAbs(path) {
+		if filepath.Base(path) == path {
+			return loadLibraryEx(path, _LOAD_LIBRARY_SEARCH_APPLICATION_DIR|_LOAD_LIBRARY_SEARCH_SYSTEM32)
+		}
+		exe, err := os.Executable()
+		if err != nil {
+			return 0, err
+		}
+		path = filepath.Join(filepath.Dir(exe), path)
+	}
+
+	// Resolve the dependencies of the library only in the library's directory and System32.
+	return loadLibraryEx(path, _LOAD_LIBRARY_SEARCH_DLL_LOAD_DIR|_LOAD_LIBRARY_SEARCH_SYSTEM32)
+}
+
+func loadLibraryEx(path string, flags uintptr) (uintptr, error) {
+	p, err := syscall.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+	handle, _, err := procLoadLibraryExW.Call(uintptr(unsafe.Pointer(p)), 0, flags)
+	if handle == 0 {
+		return 0, err
+	}
+	return handle, nil
+}
+
+func closeLib(lib uintptr) {
+	_ = syscall.FreeLibrary(syscall.Handle(lib))
+}
 
-func loadLib() (uintptr, error) {
-	dllName := "steam_api64.dll"
-	handle, err := syscall.LoadLibrary(dllName)
-	return uintptr(handle), err
+// lookupSymbol returns the address of the symbol name in the library.
+func lookupSymbol(lib uintptr, name string) (uintptr, error) {
+	return syscall.GetProcAddress(syscall.Handle(lib), name)
 }
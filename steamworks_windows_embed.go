@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+//go:build windows && steamembed && !nosteam && (amd64 || arm64)
+
+package steamworks
+
+import (
+	_ "embed"
+)
+
+// steam_api64.dll is not in this repository. Run `go run gen.go -windows` to extract it from the Steamworks SDK.
+//
+//go:embed steam_api64.dll
+var libSteamAPI []byte
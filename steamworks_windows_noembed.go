@@ -0,0 +1,9 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+//go:build windows && !steamembed && !nosteam && (amd64 || arm64)
+
+package steamworks
+
+// libSteamAPI is nil as steam_api64.dll is embedded only with the steamembed build tag.
+var libSteamAPI []byte
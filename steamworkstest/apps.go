@@ -0,0 +1,428 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworkstest
+
+import (
+	"iter"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+// DLC is a DLC in Apps.
+type DLC = steamworks.DLC
+
+// License is the license of the current user for the current app in Apps.
+type License struct {
+	Subscribed                  bool
+	SubscribedFromFreeWeekend   bool
+	SubscribedFromFamilySharing bool
+	LowViolence                 bool
+	Cybercafe                   bool
+	VACBanned                   bool
+
+	// Owner is the Steam ID of the owner of the app, who differs from the current user with Family Sharing.
+	Owner steamworks.CSteamID
+
+	// TimedTrial reports whether the license is a timed trial with TrialAllowed and TrialPlayed.
+	TimedTrial   bool
+	TrialAllowed time.Duration
+	TrialPlayed  time.Duration
+}
+
+type download struct {
+	downloaded uint64
+	total      uint64
+}
+
+// Apps is an in-memory implementation of steamworks.ISteamApps.
+type Apps struct {
+	dlcs        []DLC
+	language    string
+	installDirs map[steamworks.AppId_t]string
+	license     License
+	purchases   map[steamworks.AppId_t]time.Time
+	buildID     int32
+	betaName    string
+	languages   []string
+	queryParams map[string]string
+	commandLine string
+	downloads   map[steamworks.AppId_t]*download
+	betas       []steamworks.Beta
+
+	m sync.Mutex
+}
+
+var _ steamworks.ISteamApps = (*Apps)(nil)
+
+// AddDLC adds a DLC.
+func (a *Apps) AddDLC(dlc DLC) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.dlcs = append(a.dlcs, dlc)
+}
+
+// SetCurrentGameLanguage sets the language returned by GetCurrentGameLanguage.
+func (a *Apps) SetCurrentGameLanguage(language string) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.language = language
+}
+
+// SetAppInstallDir sets the directory returned by GetAppInstallDir.
+func (a *Apps) SetAppInstallDir(appID steamworks.AppId_t, dir string) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if a.installDirs == nil {
+		a.installDirs = map[steamworks.AppId_t]string{}
+	}
+	a.installDirs[appID] = dir
+}
+
+// SetLicense sets the license of the current user for the current app.
+func (a *Apps) SetLicense(license License) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.license = license
+}
+
+// AddSubscribedApp makes BIsSubscribedApp report true for appID, and GetEarliestPurchaseUnixTime return purchased.
+func (a *Apps) AddSubscribedApp(appID steamworks.AppId_t, purchased time.Time) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if a.purchases == nil {
+		a.purchases = map[steamworks.AppId_t]time.Time{}
+	}
+	a.purchases[appID] = purchased
+}
+
+// SetAppBuildId sets the build ID returned by GetAppBuildId.
+func (a *Apps) SetAppBuildId(buildID int32) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.buildID = buildID
+}
+
+// SetCurrentBetaName sets the beta name returned by GetCurrentBetaName.
+// An empty name means that the user is not on a beta branch.
+func (a *Apps) SetCurrentBetaName(name string) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.betaName = name
+}
+
+// SetAvailableGameLanguages sets the languages returned by GetAvailableGameLanguages.
+func (a *Apps) SetAvailableGameLanguages(languages []string) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.languages = slices.Clone(languages)
+}
+
+// SetLaunchQueryParam sets the value returned by GetLaunchQueryParam for key.
+func (a *Apps) SetLaunchQueryParam(key, value string) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if a.queryParams == nil {
+		a.queryParams = map[string]string{}
+	}
+	a.queryParams[key] = value
+}
+
+// SetLaunchCommandLine sets the command line returned by GetLaunchCommandLine.
+func (a *Apps) SetLaunchCommandLine(commandLine string) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.commandLine = commandLine
+}
+
+// SetDLCDownloadProgress sets the progress returned by GetDlcDownloadProgress for the DLC being installed by InstallDLC.
+func (a *Apps) SetDLCDownloadProgress(appID steamworks.AppId_t, bytesDownloaded, bytesTotal uint64) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	d, ok := a.downloads[appID]
+	if !ok {
+		return
+	}
+	d.downloaded = bytesDownloaded
+	d.total = bytesTotal
+}
+
+// FinishDLCInstall finishes installing the DLC requested by InstallDLC,
+// and posts steamworks.DlcInstalled to the handlers registered by steamworks.OnCallback.
+// FinishDLCInstall does nothing if the DLC is not being installed.
+func (a *Apps) FinishDLCInstall(appID steamworks.AppId_t) {
+	a.m.Lock()
+	if _, ok := a.downloads[appID]; !ok {
+		a.m.Unlock()
+		return
+	}
+	delete(a.downloads, appID)
+	a.setDLCInstalled(appID, true)
+	a.m.Unlock()
+
+	steamworks.PostCallback(steamworks.DlcInstalled{AppID: appID})
+}
+
+func (a *Apps) setDLCInstalled(appID steamworks.AppId_t, installed bool) {
+	for i := range a.dlcs {
+		if a.dlcs[i].AppID == appID {
+			a.dlcs[i].Installed = installed
+		}
+	}
+}
+
+// AddBeta adds a beta branch.
+func (a *Apps) AddBeta(beta steamworks.Beta) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	a.betas = append(a.betas, beta)
+}
+
+func (a *Apps) BGetDLCDataByIndex(iDLC int) (appID steamworks.AppId_t, available bool, pchName string, success bool) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if iDLC < 0 || iDLC >= len(a.dlcs) {
+		return 0, false, "", false
+	}
+	d := a.dlcs[iDLC]
+	return d.AppID, d.Available, d.Name, true
+}
+
+func (a *Apps) BIsDlcInstalled(appID steamworks.AppId_t) bool {
+	a.m.Lock()
+	defer a.m.Unlock()
+	for _, d := range a.dlcs {
+		if d.AppID == appID {
+			return d.Installed
+		}
+	}
+	return false
+}
+
+func (a *Apps) GetAppInstallDir(appID steamworks.AppId_t) string {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.installDirs[appID]
+}
+
+func (a *Apps) GetCurrentGameLanguage() string {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.language
+}
+
+func (a *Apps) GetDLCCount() int32 {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return int32(len(a.dlcs))
+}
+
+func (a *Apps) BIsCybercafe() bool {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.license.Cybercafe
+}
+
+func (a *Apps) BIsLowViolence() bool {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.license.LowViolence
+}
+
+func (a *Apps) BIsSubscribed() bool {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.license.Subscribed
+}
+
+func (a *Apps) BIsSubscribedApp(appID steamworks.AppId_t) bool {
+	a.m.Lock()
+	defer a.m.Unlock()
+	_, ok := a.purchases[appID]
+	return ok
+}
+
+func (a *Apps) BIsSubscribedFromFamilySharing() bool {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.license.SubscribedFromFamilySharing
+}
+
+func (a *Apps) BIsSubscribedFromFreeWeekend() bool {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.license.SubscribedFromFreeWeekend
+}
+
+func (a *Apps) BIsTimedTrial() (allowed, played time.Duration, ok bool) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if !a.license.TimedTrial {
+		return 0, 0, false
+	}
+	return a.license.TrialAllowed, a.license.TrialPlayed, true
+}
+
+func (a *Apps) BIsVACBanned() bool {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.license.VACBanned
+}
+
+func (a *Apps) GetAppBuildId() int32 {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.buildID
+}
+
+func (a *Apps) GetAppOwner() steamworks.CSteamID {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.license.Owner
+}
+
+func (a *Apps) GetAvailableGameLanguages() []string {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return slices.Clone(a.languages)
+}
+
+func (a *Apps) GetCurrentBetaName() (name string, ok bool) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.betaName, a.betaName != ""
+}
+
+func (a *Apps) GetEarliestPurchaseUnixTime(appID steamworks.AppId_t) time.Time {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.purchases[appID]
+}
+
+func (a *Apps) GetLaunchCommandLine() string {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.commandLine
+}
+
+func (a *Apps) GetLaunchQueryParam(key string) string {
+	a.m.Lock()
+	defer a.m.Unlock()
+	return a.queryParams[key]
+}
+
+// BIsAppInstalled reports whether the app has an install directory set by SetAppInstallDir, or is an installed DLC.
+func (a *Apps) BIsAppInstalled(appID steamworks.AppId_t) bool {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if _, ok := a.installDirs[appID]; ok {
+		return true
+	}
+	for _, d := range a.dlcs {
+		if d.AppID == appID {
+			return d.Installed
+		}
+	}
+	return false
+}
+
+func (a *Apps) GetDlcDownloadProgress(appID steamworks.AppId_t) (bytesDownloaded, bytesTotal uint64, ok bool) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	d, ok := a.downloads[appID]
+	if !ok {
+		return 0, 0, false
+	}
+	return d.downloaded, d.total, true
+}
+
+// InstallDLC starts installing the DLC. The installation finishes when FinishDLCInstall is called.
+// InstallDLC does nothing if the DLC is not added by AddDLC or is already installed.
+func (a *Apps) InstallDLC(appID steamworks.AppId_t) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	for _, d := range a.dlcs {
+		if d.AppID != appID {
+			continue
+		}
+		if d.Installed {
+			return
+		}
+		if a.downloads == nil {
+			a.downloads = map[steamworks.AppId_t]*download{}
+		}
+		if _, ok := a.downloads[appID]; !ok {
+			a.downloads[appID] = &download{}
+		}
+		return
+	}
+}
+
+func (a *Apps) UninstallDLC(appID steamworks.AppId_t) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	delete(a.downloads, appID)
+	a.setDLCInstalled(appID, false)
+}
+
+func (a *Apps) DLCs() iter.Seq[steamworks.DLC] {
+	return func(yield func(steamworks.DLC) bool) {
+		a.m.Lock()
+		dlcs := slices.Clone(a.dlcs)
+		a.m.Unlock()
+
+		for _, d := range dlcs {
+			if !yield(d) {
+				return
+			}
+		}
+	}
+}
+
+func (a *Apps) GetBetaInfo(index int32) (beta steamworks.Beta, ok bool) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if index < 0 || int(index) >= len(a.betas) {
+		return steamworks.Beta{}, false
+	}
+	return a.betas[index], true
+}
+
+func (a *Apps) GetNumBetas() (total, available, private int32) {
+	a.m.Lock()
+	defer a.m.Unlock()
+	for _, b := range a.betas {
+		total++
+		if b.Flags&steamworks.EBetaBranchFlags_Available != 0 {
+			available++
+		}
+		if b.Flags&steamworks.EBetaBranchFlags_Private != 0 {
+			private++
+		}
+	}
+	return
+}
+
+// SetActiveBeta selects the beta branch added by AddBeta, and makes GetCurrentBetaName return its name.
+// SetActiveBeta returns false if the branch is not added.
+func (a *Apps) SetActiveBeta(name string) bool {
+	a.m.Lock()
+	defer a.m.Unlock()
+	if !slices.ContainsFunc(a.betas, func(b steamworks.Beta) bool {
+		return b.Name == name
+	}) {
+		return false
+	}
+	for i := range a.betas {
+		if a.betas[i].Name == name {
+			a.betas[i].Flags |= steamworks.EBetaBranchFlags_Selected
+		} else {
+			a.betas[i].Flags &^= steamworks.EBetaBranchFlags_Selected
+		}
+	}
+	a.betaName = name
+	return true
+}
@@ -0,0 +1,366 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworkstest
+
+import (
+	"context"
+	"fmt"
+	"image"
+	"iter"
+	"maps"
+	"slices"
+	"sync"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+// OverlayActivation is a request to activate the overlay by one of the ActivateGameOverlay methods.
+// Only the fields for the method are set.
+type OverlayActivation struct {
+	// Dialog is the dialog for ActivateGameOverlay and ActivateGameOverlayToUser.
+	Dialog string
+
+	// User is the user for ActivateGameOverlayToUser.
+	User steamworks.CSteamID
+
+	// URL and WebPageMode are the arguments of ActivateGameOverlayToWebPage.
+	URL         string
+	WebPageMode steamworks.EActivateGameOverlayToWebPageMode
+
+	// AppID and StoreFlag are the arguments of ActivateGameOverlayToStore.
+	AppID     steamworks.AppId_t
+	StoreFlag steamworks.EOverlayToStoreFlag
+
+	// Lobby is the lobby for ActivateGameOverlayInviteDialog.
+	Lobby steamworks.CSteamID
+
+	// ConnectString is the connect string for ActivateGameOverlayInviteDialogConnectString.
+	ConnectString string
+}
+
+type avatarKey struct {
+	id   steamworks.CSteamID
+	size steamworks.AvatarSize
+}
+
+// Friends is an in-memory implementation of steamworks.ISteamFriends.
+type Friends struct {
+	personaName  string
+	richPresence map[string]string
+	friends      []steamworks.Friend
+	avatars      map[avatarKey]*image.RGBA
+	overlays     []OverlayActivation
+
+	friendRichPresence map[steamworks.CSteamID]map[string]string
+
+	m sync.Mutex
+}
+
+var _ steamworks.ISteamFriends = (*Friends)(nil)
+
+// SetPersonaName sets the name returned by GetPersonaName.
+func (f *Friends) SetPersonaName(name string) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.personaName = name
+}
+
+// RichPresence returns the rich presence value set by SetRichPresence.
+func (f *Friends) RichPresence(key string) string {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return f.richPresence[key]
+}
+
+// OverlayActivations returns the requests to activate the overlay in the called order.
+func (f *Friends) OverlayActivations() []OverlayActivation {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return slices.Clone(f.overlays)
+}
+
+// AddFriend adds a user to the friends list.
+// The user matches the flags of GetFriendCount, GetFriendByIndex and Friends by friend.Relationship.
+func (f *Friends) AddFriend(friend steamworks.Friend) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.friends = append(f.friends, friend)
+}
+
+// SetAvatar sets the avatar of a user returned by Avatar.
+// If img is nil, the avatar is removed and Avatar returns steamworks.ErrNoAvatar.
+func (f *Friends) SetAvatar(id steamworks.CSteamID, size steamworks.AvatarSize, img image.Image) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	key := avatarKey{id: id, size: size}
+	if img == nil {
+		delete(f.avatars, key)
+		return
+	}
+	if f.avatars == nil {
+		f.avatars = map[avatarKey]*image.RGBA{}
+	}
+	f.avatars[key] = toRGBA(img)
+}
+
+// SetFriendRichPresence sets the rich presence value of a friend returned by GetFriendRichPresence.
+// If value is empty, the key is removed.
+func (f *Friends) SetFriendRichPresence(friend steamworks.CSteamID, key, value string) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if value == "" {
+		delete(f.friendRichPresence[friend], key)
+		return
+	}
+	if f.friendRichPresence == nil {
+		f.friendRichPresence = map[steamworks.CSteamID]map[string]string{}
+	}
+	if f.friendRichPresence[friend] == nil {
+		f.friendRichPresence[friend] = map[string]string{}
+	}
+	f.friendRichPresence[friend][key] = value
+}
+
+func matchFriendFlags(relationship steamworks.EFriendRelationship, flags steamworks.EFriendFlags) bool {
+	if flags == steamworks.EFriendFlags_All {
+		return true
+	}
+	var flag steamworks.EFriendFlags
+	switch relationship {
+	case steamworks.EFriendRelationship_Blocked:
+		flag = steamworks.EFriendFlags_Blocked
+	case steamworks.EFriendRelationship_RequestRecipient:
+		flag = steamworks.EFriendFlags_FriendshipRequested
+	case steamworks.EFriendRelationship_Friend:
+		flag = steamworks.EFriendFlags_Immediate
+	case steamworks.EFriendRelationship_RequestInitiator:
+		flag = steamworks.EFriendFlags_RequestingFriendship
+	case steamworks.EFriendRelationship_Ignored:
+		flag = steamworks.EFriendFlags_Ignored
+	case steamworks.EFriendRelationship_IgnoredFriend:
+		flag = steamworks.EFriendFlags_IgnoredFriend
+	}
+	return flags&flag != 0
+}
+
+// friendsLocked returns the friends matching flags. The caller must hold f.m.
+func (f *Friends) friendsLocked(flags steamworks.EFriendFlags) []steamworks.Friend {
+	var friends []steamworks.Friend
+	for _, friend := range f.friends {
+		if matchFriendFlags(friend.Relationship, flags) {
+			friends = append(friends, friend)
+		}
+	}
+	return friends
+}
+
+// friendLocked returns the friend of id. The caller must hold f.m.
+func (f *Friends) friendLocked(id steamworks.CSteamID) (steamworks.Friend, bool) {
+	i := slices.IndexFunc(f.friends, func(friend steamworks.Friend) bool {
+		return friend.SteamID == id
+	})
+	if i < 0 {
+		return steamworks.Friend{}, false
+	}
+	return f.friends[i], true
+}
+
+func (f *Friends) activateOverlay(activation OverlayActivation) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.overlays = append(f.overlays, activation)
+}
+
+func (f *Friends) ActivateGameOverlay(dialog steamworks.GameOverlayDialog) {
+	f.activateOverlay(OverlayActivation{Dialog: string(dialog)})
+}
+
+func (f *Friends) ActivateGameOverlayInviteDialog(lobby steamworks.CSteamID) {
+	f.activateOverlay(OverlayActivation{Lobby: lobby})
+}
+
+func (f *Friends) ActivateGameOverlayInviteDialogConnectString(connectString string) {
+	f.activateOverlay(OverlayActivation{ConnectString: connectString})
+}
+
+func (f *Friends) ActivateGameOverlayToStore(appID steamworks.AppId_t, flag steamworks.EOverlayToStoreFlag) {
+	f.activateOverlay(OverlayActivation{AppID: appID, StoreFlag: flag})
+}
+
+func (f *Friends) ActivateGameOverlayToUser(dialog steamworks.GameOverlayUserDialog, user steamworks.CSteamID) {
+	f.activateOverlay(OverlayActivation{Dialog: string(dialog), User: user})
+}
+
+func (f *Friends) ActivateGameOverlayToWebPage(url string, mode steamworks.EActivateGameOverlayToWebPageMode) {
+	f.activateOverlay(OverlayActivation{URL: url, WebPageMode: mode})
+}
+
+func (f *Friends) Avatar(ctx context.Context, friend steamworks.CSteamID, size steamworks.AvatarSize) (*image.RGBA, error) {
+	switch size {
+	case steamworks.AvatarSizeSmall, steamworks.AvatarSizeMedium, steamworks.AvatarSizeLarge:
+	default:
+		return nil, fmt.Errorf("steamworkstest: invalid avatar size: %d", size)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	img, ok := f.avatars[avatarKey{id: friend, size: size}]
+	if !ok {
+		return nil, steamworks.ErrNoAvatar
+	}
+	return toRGBA(img), nil
+}
+
+func (f *Friends) ClearRichPresence() {
+	f.m.Lock()
+	defer f.m.Unlock()
+	clear(f.richPresence)
+}
+
+func (f *Friends) Friends(flags steamworks.EFriendFlags) iter.Seq[steamworks.Friend] {
+	return func(yield func(steamworks.Friend) bool) {
+		f.m.Lock()
+		friends := f.friendsLocked(flags)
+		f.m.Unlock()
+
+		for _, friend := range friends {
+			if !yield(friend) {
+				return
+			}
+		}
+	}
+}
+
+func (f *Friends) GetFriendByIndex(index int32, flags steamworks.EFriendFlags) steamworks.CSteamID {
+	f.m.Lock()
+	defer f.m.Unlock()
+	friends := f.friendsLocked(flags)
+	if index < 0 || int(index) >= len(friends) {
+		return 0
+	}
+	return friends[index].SteamID
+}
+
+func (f *Friends) GetFriendCount(flags steamworks.EFriendFlags) int32 {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return int32(len(f.friendsLocked(flags)))
+}
+
+func (f *Friends) GetFriendGamePlayed(friend steamworks.CSteamID) (info steamworks.FriendGameInfo, ok bool) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	v, ok := f.friendLocked(friend)
+	if !ok || !v.InGame {
+		return steamworks.FriendGameInfo{}, false
+	}
+	return v.Game, true
+}
+
+func (f *Friends) GetFriendPersonaName(friend steamworks.CSteamID) string {
+	f.m.Lock()
+	defer f.m.Unlock()
+	v, _ := f.friendLocked(friend)
+	return v.PersonaName
+}
+
+func (f *Friends) GetFriendPersonaState(friend steamworks.CSteamID) steamworks.EPersonaState {
+	f.m.Lock()
+	defer f.m.Unlock()
+	v, _ := f.friendLocked(friend)
+	return v.PersonaState
+}
+
+func (f *Friends) GetFriendRelationship(friend steamworks.CSteamID) steamworks.EFriendRelationship {
+	f.m.Lock()
+	defer f.m.Unlock()
+	v, _ := f.friendLocked(friend)
+	return v.Relationship
+}
+
+func (f *Friends) GetFriendRichPresence(friend steamworks.CSteamID, key string) string {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return f.friendRichPresence[friend][key]
+}
+
+// friendRichPresenceKeysLocked returns the rich presence keys of the friend in sorted order. The caller must hold f.m.
+func (f *Friends) friendRichPresenceKeysLocked(friend steamworks.CSteamID) []string {
+	return slices.Sorted(maps.Keys(f.friendRichPresence[friend]))
+}
+
+func (f *Friends) GetFriendRichPresenceKeyByIndex(friend steamworks.CSteamID, index int32) string {
+	f.m.Lock()
+	defer f.m.Unlock()
+	keys := f.friendRichPresenceKeysLocked(friend)
+	if index < 0 || int(index) >= len(keys) {
+		return ""
+	}
+	return keys[index]
+}
+
+func (f *Friends) GetFriendRichPresenceKeyCount(friend steamworks.CSteamID) int32 {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return int32(len(f.friendRichPresence[friend]))
+}
+
+func (f *Friends) GetFriendSteamLevel(friend steamworks.CSteamID) int32 {
+	f.m.Lock()
+	defer f.m.Unlock()
+	v, _ := f.friendLocked(friend)
+	return v.SteamLevel
+}
+
+func (f *Friends) GetPlayerNickname(player steamworks.CSteamID) string {
+	f.m.Lock()
+	defer f.m.Unlock()
+	v, _ := f.friendLocked(player)
+	return v.Nickname
+}
+
+func (f *Friends) GetPersonaName() string {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return f.personaName
+}
+
+// RequestFriendRichPresence posts steamworks.FriendRichPresenceUpdate for the friend
+// to the handlers registered by steamworks.OnCallback.
+func (f *Friends) RequestFriendRichPresence(friend steamworks.CSteamID) {
+	f.m.Lock()
+	var appID steamworks.AppId_t
+	if v, ok := f.friendLocked(friend); ok && v.InGame {
+		appID = v.Game.AppID
+	}
+	f.m.Unlock()
+
+	steamworks.PostCallback(steamworks.FriendRichPresenceUpdate{
+		SteamIDFriend: friend,
+		AppID:         appID,
+	})
+}
+
+// SetRichPresence returns false if the key or the value exceeds Steam's limits as Steam does.
+func (f *Friends) SetRichPresence(key, value string) bool {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if key == "" || len(key) > steamworks.MaxRichPresenceKeyLength || len(value) > steamworks.MaxRichPresenceValueLength {
+		return false
+	}
+	if value == "" {
+		delete(f.richPresence, key)
+		return true
+	}
+	if _, ok := f.richPresence[key]; !ok && len(f.richPresence) >= steamworks.MaxRichPresenceKeys {
+		return false
+	}
+	if f.richPresence == nil {
+		f.richPresence = map[string]string{}
+	}
+	f.richPresence[key] = value
+	return true
+}
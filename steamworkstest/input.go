@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworkstest
+
+import (
+	"sync"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+// Input is an in-memory implementation of steamworks.ISteamInput.
+type Input struct {
+	handles []steamworks.InputHandle_t
+	types   map[steamworks.InputHandle_t]steamworks.ESteamInputType
+
+	m sync.Mutex
+}
+
+var _ steamworks.ISteamInput = (*Input)(nil)
+
+// AddController adds a connected controller.
+func (i *Input) AddController(handle steamworks.InputHandle_t, inputType steamworks.ESteamInputType) {
+	i.m.Lock()
+	defer i.m.Unlock()
+	i.handles = append(i.handles, handle)
+	if i.types == nil {
+		i.types = map[steamworks.InputHandle_t]steamworks.ESteamInputType{}
+	}
+	i.types[handle] = inputType
+}
+
+// RemoveController removes a connected controller.
+func (i *Input) RemoveController(handle steamworks.InputHandle_t) {
+	i.m.Lock()
+	defer i.m.Unlock()
+	for idx, h := range i.handles {
+		if h == handle {
+			i.handles = append(i.handles[:idx], i.handles[idx+1:]...)
+			break
+		}
+	}
+	delete(i.types, handle)
+}
+
+func (i *Input) GetConnectedControllers() []steamworks.InputHandle_t {
+	i.m.Lock()
+	defer i.m.Unlock()
+	return append([]steamworks.InputHandle_t(nil), i.handles...)
+}
+
+func (i *Input) GetInputTypeForHandle(inputHandle steamworks.InputHandle_t) steamworks.ESteamInputType {
+	i.m.Lock()
+	defer i.m.Unlock()
+	return i.types[inputHandle]
+}
+
+func (i *Input) Init(bExplicitlyCallRunFrame bool) bool {
+	return true
+}
+
+func (i *Input) RunFrame() {
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworkstest
+
+import (
+	"sync"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+// RemoteStorage is an in-memory implementation of steamworks.ISteamRemoteStorage.
+type RemoteStorage struct {
+	files map[string][]byte
+
+	m sync.Mutex
+}
+
+var _ steamworks.ISteamRemoteStorage = (*RemoteStorage)(nil)
+
+// File returns the content of the file and reports whether the file exists.
+func (r *RemoteStorage) File(file string) ([]byte, bool) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	data, ok := r.files[file]
+	if !ok {
+		return nil, false
+	}
+	return append([]byte(nil), data...), true
+}
+
+func (r *RemoteStorage) FileWrite(file string, data []byte) bool {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.files == nil {
+		r.files = map[string][]byte{}
+	}
+	r.files[file] = append([]byte(nil), data...)
+	return true
+}
+
+func (r *RemoteStorage) FileWriteAsync(file string, data []byte) *steamworks.CallResult[steamworks.RemoteStorageFileWriteAsyncComplete] {
+	r.FileWrite(file, data)
+	return steamworks.CompletedCallResult(steamworks.RemoteStorageFileWriteAsyncComplete{
+		Result: steamworks.EResult_OK,
+	}, nil)
+}
+
+func (r *RemoteStorage) FileRead(file string, data []byte) int32 {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return int32(copy(data, r.files[file]))
+}
+
+func (r *RemoteStorage) FileDelete(file string) bool {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if _, ok := r.files[file]; !ok {
+		return false
+	}
+	delete(r.files, file)
+	return true
+}
+
+func (r *RemoteStorage) GetFileSize(file string) int32 {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return int32(len(r.files[file]))
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+// Package steamworkstest provides an in-memory implementation of the Steamworks interfaces for testing.
+//
+// A test installs a Steam to the steamworks package, and then the accessors like steamworks.SteamApps
+// return the in-memory implementations without a Steam client:
+//
+//	func TestLanguage(t *testing.T) {
+//		s := steamworkstest.New()
+//		s.Apps.SetCurrentGameLanguage("japanese")
+//		s.Install(t)
+//
+//		// steamworks.SteamApps().GetCurrentGameLanguage() returns "japanese".
+//	}
+package steamworkstest
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+// Steam is an in-memory implementation of the Steamworks interfaces.
+//
+// Steam implements steamworks.Backend.
+type Steam struct {
+	Apps          *Apps
+	Friends       *Friends
+	Input         *Input
+	RemoteStorage *RemoteStorage
+	User          *User
+	UserStats     *UserStats
+	Utils         *Utils
+}
+
+var _ steamworks.Backend = (*Steam)(nil)
+
+// New returns a new Steam with empty states.
+func New() *Steam {
+	return &Steam{
+		Apps:          &Apps{},
+		Friends:       &Friends{},
+		Input:         &Input{},
+		RemoteStorage: &RemoteStorage{},
+		User:          &User{},
+		UserStats:     &UserStats{},
+		Utils:         &Utils{},
+	}
+}
+
+// installed reports whether a Steam is installed by Install.
+var installed atomic.Bool
+
+// Install makes the accessors of the steamworks package return the implementations of s
+// until the test and all its subtests complete.
+//
+// The accessors are global to the process, so only one Steam can be installed at a time.
+// Install must not be used from parallel tests, i.e., tests calling t.Parallel.
+// Install fails the test if another Steam is already installed.
+func (s *Steam) Install(tb testing.TB) {
+	tb.Helper()
+	if !installed.CompareAndSwap(false, true) {
+		tb.Fatal("steamworkstest: another Steam is already installed; Install must not be used from parallel tests")
+	}
+	restore := steamworks.SetBackend(s)
+	tb.Cleanup(func() {
+		restore()
+		installed.Store(false)
+	})
+}
+
+func (s *Steam) SteamApps() steamworks.ISteamApps {
+	return s.Apps
+}
+
+func (s *Steam) SteamFriends() steamworks.ISteamFriends {
+	return s.Friends
+}
+
+func (s *Steam) SteamInput() steamworks.ISteamInput {
+	return s.Input
+}
+
+func (s *Steam) SteamRemoteStorage() steamworks.ISteamRemoteStorage {
+	return s.RemoteStorage
+}
+
+func (s *Steam) SteamUser() steamworks.ISteamUser {
+	return s.User
+}
+
+func (s *Steam) SteamUserStats() steamworks.ISteamUserStats {
+	return s.UserStats
+}
+
+func (s *Steam) SteamUtils() steamworks.ISteamUtils {
+	return s.Utils
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworkstest_test
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"testing"
+
+	"github.com/hajimehoshi/go-steamworks"
+	"github.com/hajimehoshi/go-steamworks/steamworkstest"
+)
+
+func TestApps(t *testing.T) {
+	s := steamworkstest.New()
+	s.Apps.SetCurrentGameLanguage("japanese")
+	s.Apps.SetAppBuildId(42)
+	s.Install(t)
+
+	apps := steamworks.SteamApps()
+	if got, want := apps.GetCurrentGameLanguage(), "japanese"; got != want {
+		t.Errorf("GetCurrentGameLanguage: got: %q, want: %q", got, want)
+	}
+	if got, want := apps.GetAppBuildId(), int32(42); got != want {
+		t.Errorf("GetAppBuildId: got: %d, want: %d", got, want)
+	}
+}
+
+func TestFriends(t *testing.T) {
+	s := steamworkstest.New()
+	s.Friends.SetPersonaName("Gordon")
+	s.Install(t)
+
+	friends := steamworks.SteamFriends()
+	if got, want := friends.GetPersonaName(), "Gordon"; got != want {
+		t.Errorf("GetPersonaName: got: %q, want: %q", got, want)
+	}
+	if !friends.SetRichPresence("status", "In the menu") {
+		t.Fatal("SetRichPresence failed")
+	}
+	if got, want := s.Friends.RichPresence("status"), "In the menu"; got != want {
+		t.Errorf("RichPresence: got: %q, want: %q", got, want)
+	}
+	if _, err := friends.Avatar(context.Background(), 1, steamworks.AvatarSizeSmall); !errors.Is(err, steamworks.ErrNoAvatar) {
+		t.Errorf("Avatar: got: %v, want: %v", err, steamworks.ErrNoAvatar)
+	}
+}
+
+func TestInput(t *testing.T) {
+	s := steamworkstest.New()
+	s.Input.AddController(1, steamworks.ESteamInputType_PS5Controller)
+	s.Install(t)
+
+	input := steamworks.SteamInput()
+	if got, want := input.GetConnectedControllers(), []steamworks.InputHandle_t{1}; !slices.Equal(got, want) {
+		t.Errorf("GetConnectedControllers: got: %v, want: %v", got, want)
+	}
+	if got, want := input.GetInputTypeForHandle(1), steamworks.ESteamInputType_PS5Controller; got != want {
+		t.Errorf("GetInputTypeForHandle: got: %d, want: %d", got, want)
+	}
+}
+
+func TestRemoteStorage(t *testing.T) {
+	s := steamworkstest.New()
+	s.Install(t)
+
+	storage := steamworks.SteamRemoteStorage()
+	if !storage.FileWrite("save.dat", []byte("foo")) {
+		t.Fatal("FileWrite failed")
+	}
+	if got, ok := s.RemoteStorage.File("save.dat"); !ok || string(got) != "foo" {
+		t.Errorf("File: got: %q, %t, want: %q, true", got, ok, "foo")
+	}
+	if got, want := storage.GetFileSize("save.dat"), int32(3); got != want {
+		t.Errorf("GetFileSize: got: %d, want: %d", got, want)
+	}
+
+	r, err := storage.FileWriteAsync("async.dat", []byte("bar")).Wait(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Result != steamworks.EResult_OK {
+		t.Errorf("FileWriteAsync: got: %d, want: %d", r.Result, steamworks.EResult_OK)
+	}
+	if got, ok := s.RemoteStorage.File("async.dat"); !ok || string(got) != "bar" {
+		t.Errorf("File: got: %q, %t, want: %q, true", got, ok, "bar")
+	}
+}
+
+func TestUser(t *testing.T) {
+	s := steamworkstest.New()
+	s.User.SetSteamID(76561197960287930)
+	s.Install(t)
+
+	if got, want := steamworks.SteamUser().GetSteamID(), steamworks.CSteamID(76561197960287930); got != want {
+		t.Errorf("GetSteamID: got: %d, want: %d", got, want)
+	}
+}
+
+func TestUserStats(t *testing.T) {
+	s := steamworkstest.New()
+	s.UserStats.AddAchievement("ACH_WIN_ONE_GAME")
+	s.Install(t)
+
+	stats := steamworks.SteamUserStats()
+	if !stats.SetAchievement("ACH_WIN_ONE_GAME") {
+		t.Fatal("SetAchievement failed")
+	}
+	if achieved, ok := stats.GetAchievement("ACH_WIN_ONE_GAME"); !achieved || !ok {
+		t.Errorf("GetAchievement: got: %t, %t, want: true, true", achieved, ok)
+	}
+	if stats.SetAchievement("ACH_MISSING") {
+		t.Error("SetAchievement must fail for a missing achievement")
+	}
+	if !stats.StoreStats() {
+		t.Fatal("StoreStats failed")
+	}
+	if got, want := s.UserStats.StoreCount(), 1; got != want {
+		t.Errorf("StoreCount: got: %d, want: %d", got, want)
+	}
+}
+
+func TestUtils(t *testing.T) {
+	s := steamworkstest.New()
+	s.Utils.SetOverlayEnabled(true)
+	s.Install(t)
+
+	utils := steamworks.SteamUtils()
+	if !utils.IsOverlayEnabled() {
+		t.Error("IsOverlayEnabled: got: false, want: true")
+	}
+	utils.SetOverlayNotificationPosition(steamworks.ENotificationPosition_BottomLeft)
+	if got, want := s.Utils.OverlayNotificationPosition(), steamworks.ENotificationPosition_BottomLeft; got != want {
+		t.Errorf("OverlayNotificationPosition: got: %d, want: %d", got, want)
+	}
+}
+
+func TestInstallRestore(t *testing.T) {
+	s := steamworkstest.New()
+	t.Run("install", func(t *testing.T) {
+		s.Install(t)
+		if got := steamworks.SteamApps(); got != s.Apps {
+			t.Errorf("SteamApps: got: %v, want: %v", got, s.Apps)
+		}
+	})
+
+	// The backend is removed after the test completes.
+	if got := steamworks.SteamApps(); got == s.Apps {
+		t.Errorf("SteamApps must not return the uninstalled implementation")
+	}
+
+	// Another Steam can be installed after that.
+	steamworkstest.New().Install(t)
+}
+
+// fatalTB records a call of Fatal.
+type fatalTB struct {
+	testing.TB
+	fatal bool
+}
+
+func (f *fatalTB) Fatal(args ...any) {
+	f.fatal = true
+	runtime.Goexit()
+}
+
+func TestInstallTwice(t *testing.T) {
+	steamworkstest.New().Install(t)
+
+	tb := &fatalTB{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		steamworkstest.New().Install(tb)
+	}()
+	<-done
+	if !tb.fatal {
+		t.Error("Install must fail when another Steam is already installed")
+	}
+}
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworkstest
+
+import (
+	"sync"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+// User is an in-memory implementation of steamworks.ISteamUser.
+type User struct {
+	steamID steamworks.CSteamID
+
+	m sync.Mutex
+}
+
+var _ steamworks.ISteamUser = (*User)(nil)
+
+// SetSteamID sets the ID returned by GetSteamID.
+func (u *User) SetSteamID(id steamworks.CSteamID) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.steamID = id
+}
+
+func (u *User) GetSteamID() steamworks.CSteamID {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.steamID
+}
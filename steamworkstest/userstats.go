@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworkstest
+
+import (
+	"sync"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+// UserStats is an in-memory implementation of steamworks.ISteamUserStats.
+type UserStats struct {
+	// achievements maps achievement names to whether they are achieved.
+	achievements map[string]bool
+	storeCount   int
+
+	m sync.Mutex
+}
+
+var _ steamworks.ISteamUserStats = (*UserStats)(nil)
+
+// AddAchievement adds an achievement that is not achieved yet.
+// The methods for an achievement fail unless the achievement is added.
+func (u *UserStats) AddAchievement(name string) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	if u.achievements == nil {
+		u.achievements = map[string]bool{}
+	}
+	u.achievements[name] = false
+}
+
+// StoreCount returns the number of the calls of StoreStats.
+func (u *UserStats) StoreCount() int {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.storeCount
+}
+
+func (u *UserStats) GetAchievement(name string) (achieved, success bool) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	achieved, success = u.achievements[name]
+	return
+}
+
+func (u *UserStats) SetAchievement(name string) bool {
+	u.m.Lock()
+	defer u.m.Unlock()
+	if _, ok := u.achievements[name]; !ok {
+		return false
+	}
+	u.achievements[name] = true
+	return true
+}
+
+func (u *UserStats) ClearAchievement(name string) bool {
+	u.m.Lock()
+	defer u.m.Unlock()
+	if _, ok := u.achievements[name]; !ok {
+		return false
+	}
+	u.achievements[name] = false
+	return true
+}
+
+func (u *UserStats) StoreStats() bool {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.storeCount++
+	return true
+}
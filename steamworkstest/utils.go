@@ -0,0 +1,151 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworkstest
+
+import (
+	"image"
+	"image/draw"
+	"slices"
+	"sync"
+
+	"github.com/hajimehoshi/go-steamworks"
+)
+
+// Utils is an in-memory implementation of steamworks.ISteamUtils.
+type Utils struct {
+	overlayEnabled bool
+	steamDeck      bool
+	images         []*image.RGBA
+	needsPresent   bool
+	position       steamworks.ENotificationPosition
+	positionSet    bool
+	insetX         int32
+	insetY         int32
+
+	m sync.Mutex
+}
+
+var _ steamworks.ISteamUtils = (*Utils)(nil)
+
+// SetOverlayEnabled sets the value returned by IsOverlayEnabled.
+func (u *Utils) SetOverlayEnabled(enabled bool) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.overlayEnabled = enabled
+}
+
+// SetSteamRunningOnSteamDeck sets the value returned by IsSteamRunningOnSteamDeck.
+func (u *Utils) SetSteamRunningOnSteamDeck(steamDeck bool) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.steamDeck = steamDeck
+}
+
+// SetOverlayNeedsPresent sets the value returned by BOverlayNeedsPresent.
+func (u *Utils) SetOverlayNeedsPresent(needsPresent bool) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.needsPresent = needsPresent
+}
+
+// OverlayNotificationPosition returns the position set by SetOverlayNotificationPosition.
+// The default position is steamworks.ENotificationPosition_BottomRight.
+func (u *Utils) OverlayNotificationPosition() steamworks.ENotificationPosition {
+	u.m.Lock()
+	defer u.m.Unlock()
+	if !u.positionSet {
+		return steamworks.ENotificationPosition_BottomRight
+	}
+	return u.position
+}
+
+// OverlayNotificationInset returns the inset set by SetOverlayNotificationInset.
+func (u *Utils) OverlayNotificationInset() (horizontalInset, verticalInset int32) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.insetX, u.insetY
+}
+
+// AddImage adds an image and returns its handle for GetImageSize and GetImageRGBA.
+func (u *Utils) AddImage(img image.Image) int32 {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.images = append(u.images, toRGBA(img))
+	// A valid handle is positive.
+	return int32(len(u.images))
+}
+
+// toRGBA returns a copy of img as *image.RGBA whose origin is (0, 0).
+func toRGBA(img image.Image) *image.RGBA {
+	b := img.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), img, b.Min, draw.Src)
+	return dst
+}
+
+// imageLocked returns the image of the handle. The caller must hold u.m.
+func (u *Utils) imageLocked(handle int32) *image.RGBA {
+	if handle <= 0 || int(handle) > len(u.images) {
+		return nil
+	}
+	return u.images[handle-1]
+}
+
+func (u *Utils) BOverlayNeedsPresent() bool {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.needsPresent
+}
+
+func (u *Utils) SetOverlayNotificationInset(horizontalInset, verticalInset int32) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.insetX = horizontalInset
+	u.insetY = verticalInset
+}
+
+func (u *Utils) SetOverlayNotificationPosition(notificationPosition steamworks.ENotificationPosition) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	u.position = notificationPosition
+	u.positionSet = true
+}
+
+func (u *Utils) GetImageRGBA(handle int32) ([]byte, bool) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	img := u.imageLocked(handle)
+	if img == nil {
+		return nil, false
+	}
+	return slices.Clone(img.Pix), true
+}
+
+func (u *Utils) GetImageSize(handle int32) (width, height uint32, ok bool) {
+	u.m.Lock()
+	defer u.m.Unlock()
+	img := u.imageLocked(handle)
+	if img == nil {
+		return 0, 0, false
+	}
+	return uint32(img.Rect.Dx()), uint32(img.Rect.Dy()), true
+}
+
+func (u *Utils) IsOverlayEnabled() bool {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.overlayEnabled
+}
+
+func (u *Utils) IsSteamRunningOnSteamDeck() bool {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.steamDeck
+}
+
+func (u *Utils) ShowFloatingGamepadTextInput(keyboardMode steamworks.EFloatingGamepadTextInputMode, textFieldXPosition, textFieldYPosition, textFieldWidth, textFieldHeight int32) bool {
+	u.m.Lock()
+	defer u.m.Unlock()
+	return u.steamDeck
+}
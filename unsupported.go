@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+//go:build !nosteam && !(((linux && !android) || (darwin && !ios) || (freebsd && cgo) || windows) && (amd64 || arm64))
+
+package steamworks
+
+import (
+	"fmt"
+	"runtime"
+)
+
+func init() {
+	errUnavailable = fmt.Errorf("%w: %s/%s", ErrUnsupportedPlatform, runtime.GOOS, runtime.GOARCH)
+}
@@ -0,0 +1,186 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"fmt"
+	"strings"
+)
+
+// vdfNode is a node of Valve's KeyValues text format (VDF).
+// A node has either a string value or children.
+type vdfNode struct {
+	key      string
+	value    string
+	children []*vdfNode
+
+	// isObject reports whether the node has children enclosed by braces, even if there are no children.
+	isObject bool
+}
+
+// child returns the first child of the key, compared case-insensitively as Steam does.
+func (n *vdfNode) child(key string) *vdfNode {
+	for _, c := range n.children {
+		if strings.EqualFold(c.key, key) {
+			return c
+		}
+	}
+	return nil
+}
+
+// parseVDF parses the text in VDF and returns the root node, whose children are the top-level nodes.
+// A leading UTF-8 byte order mark, which editors on Windows often add, is ignored.
+func parseVDF(text string) (*vdfNode, error) {
+	text = strings.TrimPrefix(text, "\uFEFF")
+	p := &vdfParser{text: text, line: 1}
+	root := &vdfNode{isObject: true}
+	children, err := p.parseChildren(false)
+	if err != nil {
+		return nil, err
+	}
+	root.children = children
+	return root, nil
+}
+
+type vdfParser struct {
+	text string
+	pos  int
+	line int
+}
+
+func (p *vdfParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("steamworks: VDF line %d: %s", p.line, fmt.Sprintf(format, args...))
+}
+
+// parseChildren parses nodes until a closing brace if nested is true, or until the end of the text otherwise.
+func (p *vdfParser) parseChildren(nested bool) ([]*vdfNode, error) {
+	var children []*vdfNode
+	for {
+		tok, quoted, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case tok == "" && !quoted:
+			if nested {
+				return nil, p.errorf("unexpected end of text")
+			}
+			return children, nil
+		case tok == "}" && !quoted:
+			if !nested {
+				return nil, p.errorf("unexpected '}'")
+			}
+			return children, nil
+		case tok == "{" && !quoted:
+			return nil, p.errorf("unexpected '{'")
+		}
+
+		n := &vdfNode{key: tok}
+		value, quoted, err := p.next()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case value == "{" && !quoted:
+			cs, err := p.parseChildren(true)
+			if err != nil {
+				return nil, err
+			}
+			n.children = cs
+			n.isObject = true
+		case (value == "" || value == "}") && !quoted:
+			return nil, p.errorf("missing value for key %q", n.key)
+		default:
+			n.value = value
+		}
+		children = append(children, n)
+
+		// Skip a conditional like [$WIN32] after a node.
+		p.skipSpaces()
+		if p.pos < len(p.text) && p.text[p.pos] == '[' {
+			end := strings.IndexByte(p.text[p.pos:], ']')
+			if end < 0 {
+				return nil, p.errorf("unterminated conditional")
+			}
+			p.pos += end + 1
+		}
+	}
+}
+
+// skipSpaces skips white spaces and comments.
+func (p *vdfParser) skipSpaces() {
+	for p.pos < len(p.text) {
+		switch c := p.text[p.pos]; {
+		case c == '\n':
+			p.line++
+			p.pos++
+		case c == ' ' || c == '\t' || c == '\r':
+			p.pos++
+		case strings.HasPrefix(p.text[p.pos:], "//"):
+			end := strings.IndexByte(p.text[p.pos:], '\n')
+			if end < 0 {
+				p.pos = len(p.text)
+				return
+			}
+			p.pos += end
+		default:
+			return
+		}
+	}
+}
+
+// next returns the next token. next returns an empty string and quoted=false at the end of the text.
+func (p *vdfParser) next() (tok string, quoted bool, err error) {
+	p.skipSpaces()
+	if p.pos >= len(p.text) {
+		return "", false, nil
+	}
+
+	switch c := p.text[p.pos]; c {
+	case '{', '}':
+		p.pos++
+		return string(c), false, nil
+	case '"':
+		p.pos++
+		var b strings.Builder
+		for p.pos < len(p.text) {
+			c := p.text[p.pos]
+			p.pos++
+			switch c {
+			case '"':
+				return b.String(), true, nil
+			case '\\':
+				if p.pos >= len(p.text) {
+					return "", false, p.errorf("unterminated string")
+				}
+				e := p.text[p.pos]
+				p.pos++
+				switch e {
+				case 'n':
+					b.WriteByte('\n')
+				case 't':
+					b.WriteByte('\t')
+				default:
+					b.WriteByte(e)
+				}
+			case '\n':
+				p.line++
+				b.WriteByte(c)
+			default:
+				b.WriteByte(c)
+			}
+		}
+		return "", false, p.errorf("unterminated string")
+	}
+
+	start := p.pos
+	for p.pos < len(p.text) {
+		c := p.text[p.pos]
+		if c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '"' || c == '{' || c == '}' {
+			break
+		}
+		p.pos++
+	}
+	return p.text[start:p.pos], false, nil
+}
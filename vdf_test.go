@@ -0,0 +1,196 @@
+// SPDX-License-Identifier: Apache-2.0
+// SPDX-FileCopyrightText: 2026 The go-steamworks Authors
+
+package steamworks
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// dumpVDF returns a compact form of the children of n, e.g., `"a"="b" "c"{"d"="e"}`.
+func dumpVDF(n *vdfNode) string {
+	var strs []string
+	for _, c := range n.children {
+		if c.isObject {
+			strs = append(strs, strconv.Quote(c.key)+"{"+dumpVDF(c)+"}")
+			continue
+		}
+		strs = append(strs, strconv.Quote(c.key)+"="+strconv.Quote(c.value))
+	}
+	return strings.Join(strs, " ")
+}
+
+func TestParseVDF(t *testing.T) {
+	testCases := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "empty",
+			text: "",
+			want: "",
+		},
+		{
+			name: "nested",
+			text: `"lang"
+{
+	"english"
+	{
+		"tokens"
+		{
+			"#A"	"a"
+			"#B"	"b"
+		}
+	}
+	"empty" {}
+}`,
+			want: `"lang"{"english"{"tokens"{"#A"="a" "#B"="b"}} "empty"{}}`,
+		},
+		{
+			name: "escapes",
+			text: `"a" "x\"y\\z\n\t\q"`,
+			want: `"a"="x\"y\\z\n\tq"`,
+		},
+		{
+			name: "multi-line value",
+			text: "\"a\" \"x\ny\"",
+			want: `"a"="x\ny"`,
+		},
+		{
+			name: "comments",
+			text: `// comment
+"a" "b" // trailing comment
+// "c" "d"
+"e" { // comment after a brace
+	"f" "g"
+}
+// comment at the end without a newline`,
+			want: `"a"="b" "e"{"f"="g"}`,
+		},
+		{
+			name: "unquoted",
+			text: `a b
+c { d e }`,
+			want: `"a"="b" "c"{"d"="e"}`,
+		},
+		{
+			name: "quoted braces",
+			text: `"{" "}"`,
+			want: `"{"="}"`,
+		},
+		{
+			name: "conditionals",
+			text: `"a" "b" [$WIN32]
+"c" { "d" "e" } [!$OSX]`,
+			want: `"a"="b" "c"{"d"="e"}`,
+		},
+		{
+			name: "BOM",
+			text: "\uFEFF\"lang\" { \"a\" \"b\" }",
+			want: `"lang"{"a"="b"}`,
+		},
+		{
+			name: "CRLF",
+			text: "\"a\"\r\n{\r\n\t\"b\" \"c\"\r\n}\r\n",
+			want: `"a"{"b"="c"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			root, err := parseVDF(tc.text)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := dumpVDF(root); got != tc.want {
+				t.Errorf("got: %s, want: %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseVDFError(t *testing.T) {
+	testCases := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "missing value",
+			text: `"a"`,
+			want: `line 1: missing value for key "a"`,
+		},
+		{
+			name: "missing value before a brace",
+			text: `"a" { "b" }`,
+			want: `line 1: missing value for key "b"`,
+		},
+		{
+			name: "missing closing brace",
+			text: "\"a\"\n{\n\t\"b\" \"c\"\n",
+			want: "line 4: unexpected end of text",
+		},
+		{
+			name: "unexpected closing brace",
+			text: "\"a\" \"b\"\n}",
+			want: "line 2: unexpected '}'",
+		},
+		{
+			name: "unexpected opening brace",
+			text: `{ "a" "b" }`,
+			want: "line 1: unexpected '{'",
+		},
+		{
+			name: "unterminated string",
+			text: "\"a\" \"b\n",
+			want: "line 2: unterminated string",
+		},
+		{
+			name: "unterminated escape",
+			text: `"a" "b\`,
+			want: "line 1: unterminated string",
+		},
+		{
+			name: "unterminated conditional",
+			text: `"a" "b" [$WIN32`,
+			want: "line 1: unterminated conditional",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := parseVDF(tc.text)
+			if err == nil {
+				t.Fatal("parseVDF must fail")
+			}
+			if !strings.Contains(err.Error(), tc.want) {
+				t.Errorf("got: %v, want: an error containing %q", err, tc.want)
+			}
+		})
+	}
+}
+
+func TestVDFNodeChild(t *testing.T) {
+	root, err := parseVDF(`"Lang" { "Tokens" { "#A" "a" } "tokens" { "#B" "b" } }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lang := root.child("lang")
+	if lang == nil {
+		t.Fatal("child(\"lang\") must not be nil")
+	}
+	// The first child is returned for the same keys in different cases.
+	tokens := lang.child("TOKENS")
+	if tokens == nil {
+		t.Fatal("child(\"TOKENS\") must not be nil")
+	}
+	if got, want := dumpVDF(tokens), `"#A"="a"`; got != want {
+		t.Errorf("got: %s, want: %s", got, want)
+	}
+	if c := lang.child("missing"); c != nil {
+		t.Errorf("child(\"missing\"): got: %v, want: nil", c)
+	}
+}